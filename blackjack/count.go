@@ -0,0 +1,122 @@
+package blackjack
+
+import (
+	"github.com/anthonyrouseau/games/cards"
+)
+
+//System is a card counting system adding a tag to the running count for each card seen.
+type System struct {
+	Name string
+	//Tags holds the tag of each rank indexed by rank, from the Ace at 1 to the King at 13.
+	Tags [cards.King + 1]int
+	//InitialPerDeck is added to the starting running count for each deck after the first.
+	InitialPerDeck int
+}
+
+//Counting systems
+var (
+	//HiLo counts 2 to 6 as +1 and tens and Aces as -1.
+	HiLo = System{
+		Name: "Hi-Lo",
+		Tags: [cards.King + 1]int{0, -1, 1, 1, 1, 1, 1, 0, 0, 0, -1, -1, -1, -1},
+	}
+	//KO counts 2 to 7 as +1 and tens and Aces as -1.
+	//It is unbalanced so the running count starts at -4 for each deck after the first.
+	KO = System{
+		Name:           "KO",
+		Tags:           [cards.King + 1]int{0, -1, 1, 1, 1, 1, 1, 1, 0, 0, -1, -1, -1, -1},
+		InitialPerDeck: -4,
+	}
+	//OmegaII counts 2, 3 and 7 as +1, 4 to 6 as +2, 9 as -1 and tens as -2, Aces are not counted.
+	OmegaII = System{
+		Name: "Omega II",
+		Tags: [cards.King + 1]int{0, 0, 1, 1, 2, 2, 2, 1, 0, -1, -2, -2, -2, -2},
+	}
+)
+
+//Tag returns the tag of a rank, 0 for ranks the system does not count.
+func (s System) Tag(rank cards.Rank) int {
+	if rank < cards.Ace || rank > cards.King {
+		return 0
+	}
+	return s.Tags[rank]
+}
+
+//Balanced returns true if a full deck counts to 0.
+func (s System) Balanced() bool {
+	sum := 0
+	for _, tag := range s.Tags {
+		sum += tag
+	}
+	return sum == 0
+}
+
+//Counter keeps the running count of the cards seen from a shoe.
+type Counter struct {
+	system  System
+	shoe    *cards.Shoe
+	running int
+	dealt   int
+}
+
+//NewCounter returns a counter using the system for cards leaving the shoe.
+func NewCounter(system System, shoe *cards.Shoe) *Counter {
+	c := &Counter{system: system, shoe: shoe}
+	c.Reset()
+	return c
+}
+
+//System returns the counting system of the counter.
+func (c *Counter) System() System {
+	return c.system
+}
+
+//Reset returns the running count to its starting value for a freshly shuffled shoe.
+func (c *Counter) Reset() {
+	c.running = c.system.InitialPerDeck * (c.shoe.Decks() - 1)
+	c.dealt = c.shoe.Dealt()
+}
+
+//Count adds the tags of cards seen to the running count.
+//
+//The count is reset first if the shoe has been reshuffled since the last count.
+func (c *Counter) Count(cs ...cards.Card) {
+	if c.shoe.Dealt() < c.dealt {
+		c.Reset()
+	}
+	c.dealt = c.shoe.Dealt()
+	for _, card := range cs {
+		c.running += c.system.Tag(card.Rank())
+	}
+}
+
+//CountRound counts every card of a finished round of the game, including the dealer's hole card.
+//
+//Errors if the round is not over.
+func (c *Counter) CountRound(g *Game) error {
+	if g.Phase() != RoundOver {
+		return &InvalidAction{action: "CountRound"}
+	}
+	seen := g.DealerCards()
+	for _, hand := range g.Hands() {
+		seen = append(seen, hand.Cards()...)
+	}
+	c.Count(seen...)
+	return nil
+}
+
+//RunningCount returns the sum of the tags of the cards seen.
+func (c *Counter) RunningCount() int {
+	return c.running
+}
+
+//TrueCount returns the running count per deck remaining in the shoe.
+//
+//The decks remaining are never taken as less than half a deck.
+func (c *Counter) TrueCount() float64 {
+	decks := c.shoe.DecksRemaining()
+	if decks < 0.5 {
+		decks = 0.5
+	}
+	return float64(c.running) / decks
+}
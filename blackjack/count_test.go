@@ -0,0 +1,64 @@
+package blackjack
+
+import (
+	"testing"
+
+	"github.com/anthonyrouseau/games/cards"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSystem(t *testing.T) {
+	assert := assert.New(t)
+	assert.True(HiLo.Balanced())
+	assert.False(KO.Balanced())
+	assert.True(OmegaII.Balanced())
+	assert.Equal(1, HiLo.Tag(cards.Six))
+	assert.Equal(-1, HiLo.Tag(cards.Ace))
+	assert.Equal(1, KO.Tag(cards.Seven))
+	assert.Equal(2, OmegaII.Tag(cards.Five))
+	assert.Equal(0, OmegaII.Tag(cards.Ace))
+	assert.Equal(0, HiLo.Tag(cards.BigJoker))
+}
+
+func TestCounter(t *testing.T) {
+	assert := assert.New(t)
+	shoe, err := cards.NewShoe(6)
+	assert.NoError(err)
+	counter := NewCounter(HiLo, &shoe)
+	assert.Equal(-20, NewCounter(KO, &shoe).RunningCount())
+	dealt, err := shoe.Pick([]int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25})
+	assert.NoError(err)
+	counter.Count(mustParse("2c 3d 4h 5s 6c Kd")...)
+	assert.Equal(4, counter.RunningCount())
+	assert.InDelta(4/(float64(312-len(dealt))/52), counter.TrueCount(), 1e-9)
+	shoe.Discard(dealt...)
+	shoe.Reshuffle()
+	counter.Count(mustParse("Ac")...)
+	assert.Equal(-1, counter.RunningCount())
+}
+
+func TestCounterSystemsOverShoe(t *testing.T) {
+	assert := assert.New(t)
+	for _, system := range []System{HiLo, KO, OmegaII} {
+		shoe, _ := cards.NewShoe(2)
+		counter := NewCounter(system, &shoe)
+		all := shoe.Cards()
+		counter.Count(all...)
+		if system.Balanced() {
+			assert.Equal(0, counter.RunningCount(), system.Name)
+		} else {
+			assert.Equal(4, counter.RunningCount(), system.Name)
+		}
+	}
+}
+
+func TestCounterCountRound(t *testing.T) {
+	assert := assert.New(t)
+	game := stackedGame(DefaultRules(), "Tc 6h 9d 5s 4c")
+	counter := NewCounter(HiLo, game.Shoe())
+	assert.NoError(game.Deal(10))
+	assert.IsType(&InvalidAction{}, counter.CountRound(game))
+	assert.NoError(game.Act(Stand))
+	assert.NoError(counter.CountRound(game))
+	assert.Equal(2, counter.RunningCount())
+}
@@ -0,0 +1,56 @@
+package blackjack
+
+import (
+	"fmt"
+)
+
+//InvalidRules signals a rule that can not be used.
+//
+//e.g. a shoe of 0 decks.
+type InvalidRules struct {
+	rule string
+}
+
+func (e *InvalidRules) Error() string {
+	return fmt.Sprintf("Rule %s is not valid.", e.rule)
+}
+
+//InvalidAction signals an action that is not allowed at this point of the round.
+//
+//e.g. doubling a hand of three cards.
+type InvalidAction struct {
+	action string
+}
+
+func (e *InvalidAction) Error() string {
+	return fmt.Sprintf("Action %s is not allowed now.", e.action)
+}
+
+//InvalidSeat signals a seat that is not playing the round.
+type InvalidSeat struct {
+	seat int
+}
+
+func (e *InvalidSeat) Error() string {
+	return fmt.Sprintf("Seat %d is not playing.", e.seat)
+}
+
+//InvalidBet signals a bet that can not be placed.
+//
+//e.g. a bet of 0.
+type InvalidBet struct {
+	bet float64
+}
+
+func (e *InvalidBet) Error() string {
+	return fmt.Sprintf("Bet %v is not valid.", e.bet)
+}
+
+//EmptyShoe signals a card needed when every card is held in the round being played.
+//
+//e.g. many seats splitting on a shoe of one deck.
+type EmptyShoe struct{}
+
+func (e *EmptyShoe) Error() string {
+	return "The shoe and the discard tray have no cards left."
+}
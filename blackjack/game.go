@@ -0,0 +1,467 @@
+package blackjack
+
+import (
+	"github.com/anthonyrouseau/games/cards"
+)
+
+//Phase is the point a round of blackjack has reached.
+type Phase int
+
+//Phase values
+const (
+	//Betting waits for bets before the first round.
+	Betting Phase = iota
+	//Offers waits for insurance and early surrender before the dealer checks for blackjack.
+	Offers
+	//PlayerTurns waits for the player of the current hand to act.
+	PlayerTurns
+	//RoundOver has settled every hand and waits for the bets of the next round.
+	RoundOver
+)
+
+//Action is a move a player makes on a hand.
+type Action int
+
+//Action values
+const (
+	Stand Action = iota + 1
+	Hit
+	Double
+	Split
+	Surrender
+)
+
+var actionNames = map[Action]string{
+	Stand:     "Stand",
+	Hit:       "Hit",
+	Double:    "Double",
+	Split:     "Split",
+	Surrender: "Surrender",
+}
+
+//String returns the name of the action.
+func (a Action) String() string {
+	if name, ok := actionNames[a]; ok {
+		return name
+	}
+	return "Unknown"
+}
+
+//Game is a blackjack table dealing rounds from a shoe to one or more seats.
+//
+//A round starts with Deal, which takes a bet for each seat.
+//When the dealer shows an Ace with insurance, or an Ace or ten with early surrender,
+//the round waits in the Offers phase for Insure and SurrenderEarly until Peek is called.
+//Hands are then played in order with Act until each is finished,
+//after which the dealer plays and every hand is settled.
+type Game struct {
+	rules        Rules
+	shoe         *cards.Shoe
+	phase        Phase
+	hands        []*Hand
+	dealer       *Hand
+	current      int
+	bets         []float64
+	insurance    []float64
+	insuranceNet []float64
+}
+
+//NewGame returns a game with the rules dealing from a shuffled shoe.
+//
+//Errors if the rules can not be used.
+func NewGame(rules Rules) (*Game, error) {
+	if err := rules.validate(); err != nil {
+		return nil, err
+	}
+	shoe, err := cards.NewShoe(rules.Decks)
+	if err != nil {
+		return nil, err
+	}
+	shoe.Shuffle()
+	return NewGameWithShoe(rules, &shoe)
+}
+
+//NewGameWithShoe returns a game with the rules dealing from the shoe as it is.
+//
+//The shoe's decks are used in place of Rules.Decks and its cut card is placed by Rules.Penetration.
+//Errors if the rules can not be used.
+func NewGameWithShoe(rules Rules, shoe *cards.Shoe) (*Game, error) {
+	rules.Decks = shoe.Decks()
+	if err := rules.validate(); err != nil {
+		return nil, err
+	}
+	if err := shoe.SetPenetration(rules.Penetration); err != nil {
+		return nil, err
+	}
+	return &Game{rules: rules, shoe: shoe}, nil
+}
+
+//Rules returns the rules of the game.
+func (g *Game) Rules() Rules {
+	return g.rules
+}
+
+//Shoe returns the shoe the game deals from.
+func (g *Game) Shoe() *cards.Shoe {
+	return g.shoe
+}
+
+//Phase returns the point the current round has reached.
+func (g *Game) Phase() Phase {
+	return g.phase
+}
+
+//Hands returns the hands of the round in the order they are played.
+//
+//Splitting a hand places the new hand straight after it.
+func (g *Game) Hands() []*Hand {
+	return append([]*Hand{}, g.hands...)
+}
+
+//Current returns the hand waiting for an action, nil outside the PlayerTurns phase.
+func (g *Game) Current() *Hand {
+	if g.phase != PlayerTurns {
+		return nil
+	}
+	return g.hands[g.current]
+}
+
+//DealerUpCard returns the dealer's face up card.
+func (g *Game) DealerUpCard() cards.Card {
+	if g.dealer == nil {
+		return cards.Card{}
+	}
+	card, _ := g.dealer.PeekTop()
+	return card
+}
+
+//DealerCards returns the dealer's cards, only the up card until the round is over.
+func (g *Game) DealerCards() []cards.Card {
+	if g.dealer == nil {
+		return nil
+	}
+	if g.phase != RoundOver {
+		return []cards.Card{g.DealerUpCard()}
+	}
+	return g.dealer.Cards()
+}
+
+//Deal starts a round with a hand for each bet, seats are numbered in the order of the bets.
+//
+//The cards of the last round are discarded first and the shoe is reshuffled
+//once its cut card has been reached.
+//Errors if a round is being played, there are no bets or a bet is not positive,
+//or with EmptyShoe if the cards run out, in which case the round is not dealt.
+func (g *Game) Deal(bets ...float64) error {
+	if g.phase != Betting && g.phase != RoundOver {
+		return &InvalidAction{action: "Deal"}
+	}
+	if len(bets) == 0 {
+		return &InvalidBet{bet: 0}
+	}
+	for _, bet := range bets {
+		if bet <= 0 {
+			return &InvalidBet{bet: bet}
+		}
+	}
+	g.collect()
+	if g.shoe.NeedsReshuffle() {
+		g.shoe.Reshuffle()
+	}
+	g.bets = append([]float64{}, bets...)
+	g.hands = make([]*Hand, len(bets))
+	for seat, bet := range bets {
+		g.hands[seat] = newHand(seat, bet)
+	}
+	g.dealer = newHand(-1, 0)
+	g.insurance = make([]float64, len(bets))
+	g.insuranceNet = make([]float64, len(bets))
+	g.current = 0
+	for round := 0; round < 2; round++ {
+		for _, hand := range append(append([]*Hand{}, g.hands...), g.dealer) {
+			card, err := g.draw()
+			if err != nil {
+				g.collect()
+				return err
+			}
+			hand.add(card)
+		}
+	}
+	up := g.DealerUpCard().Rank()
+	if (g.rules.Insurance && up == cards.Ace) ||
+		(g.rules.Surrender == EarlySurrender && (up == cards.Ace || CardValue(up) == 10)) {
+		g.phase = Offers
+		return nil
+	}
+	return g.peek()
+}
+
+//Insure places an insurance bet of half the seat's bet against a dealer blackjack.
+//
+//Insurance pays 2:1. Errors outside the Offers phase, when insurance is not offered,
+//or when the seat is not playing, has already insured or has surrendered.
+func (g *Game) Insure(seat int) error {
+	if g.phase != Offers || !g.rules.Insurance || g.DealerUpCard().Rank() != cards.Ace {
+		return &InvalidAction{action: "Insure"}
+	}
+	if seat < 0 || seat >= len(g.hands) {
+		return &InvalidSeat{seat: seat}
+	}
+	if g.insurance[seat] != 0 || g.hands[seat].surrendered {
+		return &InvalidAction{action: "Insure"}
+	}
+	g.insurance[seat] = g.bets[seat] / 2
+	return nil
+}
+
+//SurrenderEarly gives up half the seat's bet before the dealer checks for blackjack.
+//
+//Errors outside the Offers phase, without the EarlySurrender rule,
+//or when the seat is not playing, has insured or has already surrendered.
+func (g *Game) SurrenderEarly(seat int) error {
+	if g.phase != Offers || g.rules.Surrender != EarlySurrender {
+		return &InvalidAction{action: "SurrenderEarly"}
+	}
+	if seat < 0 || seat >= len(g.hands) {
+		return &InvalidSeat{seat: seat}
+	}
+	if g.insurance[seat] != 0 || g.hands[seat].surrendered {
+		return &InvalidAction{action: "SurrenderEarly"}
+	}
+	g.hands[seat].surrendered = true
+	g.hands[seat].finished = true
+	return nil
+}
+
+//Peek closes the Offers phase and has the dealer check for blackjack.
+//
+//Errors outside the Offers phase or with EmptyShoe if the dealer runs out of cards.
+func (g *Game) Peek() error {
+	if g.phase != Offers {
+		return &InvalidAction{action: "Peek"}
+	}
+	return g.peek()
+}
+
+//peek ends the round on a dealer blackjack when the dealer peeks, otherwise starts the player turns.
+func (g *Game) peek() error {
+	up := g.DealerUpCard().Rank()
+	if g.rules.DealerPeeks && (up == cards.Ace || CardValue(up) == 10) && g.dealer.IsBlackjack() {
+		g.settle()
+		return nil
+	}
+	g.phase = PlayerTurns
+	return g.advance()
+}
+
+//Actions returns the actions allowed on the current hand, nil outside the PlayerTurns phase.
+func (g *Game) Actions() []Action {
+	if g.phase != PlayerTurns {
+		return nil
+	}
+	hand := g.hands[g.current]
+	hands := 0
+	for _, other := range g.hands {
+		if other.seat == hand.seat {
+			hands++
+		}
+	}
+	actions := []Action{}
+	for _, action := range g.rules.actions(hand) {
+		if action != Split || hands < g.rules.MaxHands {
+			actions = append(actions, action)
+		}
+	}
+	return actions
+}
+
+//Act plays an action on the current hand.
+//
+//Errors if the action is not one of Actions,
+//or with EmptyShoe if a card is needed when the shoe and the discard tray are both empty.
+func (g *Game) Act(action Action) error {
+	allowed := false
+	for _, a := range g.Actions() {
+		allowed = allowed || a == action
+	}
+	if !allowed {
+		return &InvalidAction{action: action.String()}
+	}
+	hand := g.hands[g.current]
+	switch action {
+	case Stand:
+		hand.finished = true
+	case Hit:
+		card, err := g.draw()
+		if err != nil {
+			return err
+		}
+		hand.add(card)
+		if total, _ := hand.Total(); total >= 21 {
+			hand.finished = true
+		}
+	case Double:
+		card, err := g.draw()
+		if err != nil {
+			return err
+		}
+		hand.bet *= 2
+		hand.doubled = true
+		hand.add(card)
+		hand.finished = true
+	case Split:
+		second, _ := hand.PickBottom()
+		aces := second.Rank() == cards.Ace
+		hand.split, hand.splitAces = true, aces
+		splitHand := newHand(hand.seat, g.bets[hand.seat])
+		splitHand.split, splitHand.splitAces = true, aces
+		splitHand.add(second)
+		g.hands = append(g.hands[:g.current+1], append([]*Hand{splitHand}, g.hands[g.current+1:]...)...)
+	case Surrender:
+		hand.surrendered = true
+		hand.finished = true
+	}
+	return g.advance()
+}
+
+//advance moves to the next hand needing an action, dealing the second card of split hands,
+//and once every hand is finished plays the dealer's hand and settles the round.
+func (g *Game) advance() error {
+	for ; g.current < len(g.hands); g.current++ {
+		hand := g.hands[g.current]
+		if hand.CardCount() == 1 {
+			card, err := g.draw()
+			if err != nil {
+				return err
+			}
+			hand.add(card)
+			if hand.splitAces && !g.rules.HitSplitAces && !g.canResplit(hand) {
+				hand.finished = true
+			}
+		}
+		if total, _ := hand.Total(); total >= 21 {
+			hand.finished = true
+		}
+		if !hand.finished {
+			return nil
+		}
+	}
+	if err := g.playDealer(); err != nil {
+		return err
+	}
+	g.settle()
+	return nil
+}
+
+//canResplit returns true if the hand is a pair the seat can still split.
+func (g *Game) canResplit(hand *Hand) bool {
+	for _, action := range g.Actions() {
+		if action == Split {
+			return true
+		}
+	}
+	return false
+}
+
+//playDealer draws to the dealer's hand while any player hand is still waiting on it.
+func (g *Game) playDealer() error {
+	waiting := false
+	for _, hand := range g.hands {
+		waiting = waiting || !(hand.surrendered || hand.IsBust() || hand.IsBlackjack())
+	}
+	if !waiting {
+		return nil
+	}
+	for {
+		total, soft := g.dealer.Total()
+		if total > 17 || (total == 17 && !(soft && g.rules.HitSoft17)) {
+			return nil
+		}
+		card, err := g.draw()
+		if err != nil {
+			return err
+		}
+		g.dealer.add(card)
+	}
+}
+
+//settle works out the outcome of every hand and insurance bet and ends the round.
+func (g *Game) settle() {
+	dealerTotal, _ := g.dealer.Total()
+	dealerBlackjack := g.dealer.IsBlackjack()
+	for _, hand := range g.hands {
+		total, _ := hand.Total()
+		switch {
+		case hand.surrendered:
+			hand.outcome, hand.net = Surrendered, -hand.bet/2
+		case hand.IsBlackjack() && dealerBlackjack:
+			hand.outcome, hand.net = Push, 0
+		case hand.IsBlackjack():
+			hand.outcome, hand.net = Blackjack, hand.bet*g.rules.BlackjackPayout
+		case hand.IsBust() || dealerBlackjack:
+			hand.outcome, hand.net = Lose, -hand.bet
+		case dealerTotal > 21 || total > dealerTotal:
+			hand.outcome, hand.net = Win, hand.bet
+		case total == dealerTotal:
+			hand.outcome, hand.net = Push, 0
+		default:
+			hand.outcome, hand.net = Lose, -hand.bet
+		}
+		hand.finished = true
+	}
+	for seat, stake := range g.insurance {
+		if dealerBlackjack {
+			g.insuranceNet[seat] = 2 * stake
+		} else {
+			g.insuranceNet[seat] = -stake
+		}
+	}
+	g.phase = RoundOver
+}
+
+//InsuranceNet returns the amount the seat won, or lost if negative, on insurance once the round is over.
+func (g *Game) InsuranceNet(seat int) float64 {
+	if g.phase != RoundOver || seat < 0 || seat >= len(g.insuranceNet) {
+		return 0
+	}
+	return g.insuranceNet[seat]
+}
+
+//Net returns the amount the seat won, or lost if negative, over its hands and insurance once the round is over.
+func (g *Game) Net(seat int) float64 {
+	if g.phase != RoundOver {
+		return 0
+	}
+	net := g.InsuranceNet(seat)
+	for _, hand := range g.hands {
+		if hand.seat == seat {
+			net += hand.net
+		}
+	}
+	return net
+}
+
+//draw returns the top card of the shoe, reshuffling the discard tray into an empty shoe.
+//
+//Errors if the shoe and the discard tray are both empty.
+func (g *Game) draw() (cards.Card, error) {
+	if g.shoe.CardCount() == 0 {
+		g.shoe.Reshuffle()
+	}
+	card, err := g.shoe.PickTop()
+	if err != nil {
+		return cards.Card{}, &EmptyShoe{}
+	}
+	return card, nil
+}
+
+//collect moves the cards of the last round to the discard tray.
+func (g *Game) collect() {
+	for _, hand := range g.hands {
+		g.shoe.Discard(hand.Cards()...)
+	}
+	if g.dealer != nil {
+		g.shoe.Discard(g.dealer.Cards()...)
+	}
+	g.hands, g.dealer = nil, nil
+}
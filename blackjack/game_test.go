@@ -0,0 +1,287 @@
+package blackjack
+
+import (
+	"testing"
+
+	"github.com/anthonyrouseau/games/cards"
+	"github.com/stretchr/testify/assert"
+)
+
+//stackedGame returns a game with the rules dealing the given cards first.
+func stackedGame(rules Rules, notation string) *Game {
+	shoe, err := cards.NewShoe(1)
+	if err != nil {
+		panic(err)
+	}
+	stack := mustParse(notation)
+	indices := []int{}
+	for _, card := range stack {
+		for i, inShoe := range shoe.Cards() {
+			if inShoe == card {
+				indices = append(indices, i)
+				break
+			}
+		}
+	}
+	picked, err := shoe.Pick(indices)
+	if err != nil {
+		panic(err)
+	}
+	top := make([]int, len(picked))
+	for i := range top {
+		top[i] = i
+	}
+	if err := shoe.Place(picked, top); err != nil {
+		panic(err)
+	}
+	game, err := NewGameWithShoe(rules, &shoe)
+	if err != nil {
+		panic(err)
+	}
+	return game
+}
+
+func TestGameBlackjack(t *testing.T) {
+	assert := assert.New(t)
+	game := stackedGame(DefaultRules(), "Ah 9c Kd 7s")
+	assert.NoError(game.Deal(10))
+	assert.Equal(RoundOver, game.Phase())
+	assert.Equal(Blackjack, game.Hands()[0].Outcome())
+	assert.Equal(15.0, game.Net(0))
+	assert.Equal(mustParse("9c 7s"), game.DealerCards())
+
+	rules := DefaultRules()
+	rules.BlackjackPayout = 1.2
+	game = stackedGame(rules, "Ah 9c Kd 7s")
+	assert.NoError(game.Deal(10))
+	assert.Equal(12.0, game.Net(0))
+}
+
+func TestGameDealerSoft17(t *testing.T) {
+	assert := assert.New(t)
+	for _, hitSoft17 := range []bool{false, true} {
+		rules := DefaultRules()
+		rules.HitSoft17 = hitSoft17
+		game := stackedGame(rules, "Tc Ah 9d 6s 4c")
+		assert.NoError(game.Deal(10))
+		assert.Equal(Offers, game.Phase())
+		assert.Equal(mustParse("Ah"), game.DealerCards())
+		assert.NoError(game.Peek())
+		assert.Equal(PlayerTurns, game.Phase())
+		assert.NoError(game.Act(Stand))
+		assert.Equal(RoundOver, game.Phase())
+		if hitSoft17 {
+			assert.Equal(mustParse("Ah 6s 4c"), game.DealerCards())
+			assert.Equal(-10.0, game.Net(0))
+		} else {
+			assert.Equal(mustParse("Ah 6s"), game.DealerCards())
+			assert.Equal(10.0, game.Net(0))
+		}
+	}
+}
+
+func TestGameSplitAndDouble(t *testing.T) {
+	assert := assert.New(t)
+	game := stackedGame(DefaultRules(), "8c 6h 8d Ts 3h Kc Tc 9s")
+	assert.NoError(game.Deal(10))
+	assert.Equal([]Action{Stand, Hit, Double, Split, Surrender}, game.Actions())
+	assert.NoError(game.Act(Split))
+	assert.Equal(mustParse("8c 3h"), game.Current().Cards())
+	assert.Equal([]Action{Stand, Hit, Double}, game.Actions())
+	assert.NoError(game.Act(Double))
+	assert.Equal(mustParse("8d Tc"), game.Current().Cards())
+	assert.NoError(game.Act(Stand))
+	assert.Equal(RoundOver, game.Phase())
+	hands := game.Hands()
+	assert.Len(hands, 2)
+	assert.True(hands[0].IsDoubled())
+	assert.Equal(20.0, hands[0].Net())
+	assert.Equal(10.0, hands[1].Net())
+	assert.Equal(30.0, game.Net(0))
+
+	rules := DefaultRules()
+	rules.DoubleAfterSplit = false
+	game = stackedGame(rules, "8c 6h 8d Ts 3h Kc Tc 9s")
+	assert.NoError(game.Deal(10))
+	assert.NoError(game.Act(Split))
+	assert.IsType(&InvalidAction{}, game.Act(Double))
+}
+
+func TestGameResplit(t *testing.T) {
+	assert := assert.New(t)
+	game := stackedGame(DefaultRules(), "8c 6h 8d Ts 8h")
+	assert.NoError(game.Deal(10))
+	assert.NoError(game.Act(Split))
+	assert.Contains(game.Actions(), Split)
+
+	rules := DefaultRules()
+	rules.MaxHands = 2
+	game = stackedGame(rules, "8c 6h 8d Ts 8h")
+	assert.NoError(game.Deal(10))
+	assert.NoError(game.Act(Split))
+	assert.NotContains(game.Actions(), Split)
+
+	rules.MaxHands = 1
+	game = stackedGame(rules, "8c 6h 8d Ts 8h")
+	assert.NoError(game.Deal(10))
+	assert.NotContains(game.Actions(), Split)
+}
+
+func TestGameSplitAces(t *testing.T) {
+	assert := assert.New(t)
+	game := stackedGame(DefaultRules(), "Ac 6h Ad Ts 9c Kc 2d")
+	assert.NoError(game.Deal(10))
+	assert.NoError(game.Act(Split))
+	assert.Equal(RoundOver, game.Phase())
+	hands := game.Hands()
+	assert.Equal(mustParse("Ac 9c"), hands[0].Cards())
+	assert.Equal(mustParse("Ad Kc"), hands[1].Cards())
+	assert.Equal(Win, hands[1].Outcome())
+	assert.Equal(20.0, game.Net(0))
+
+	game = stackedGame(DefaultRules(), "Ac 6h Ad Ts Ah Kc 2d")
+	assert.NoError(game.Deal(10))
+	assert.NoError(game.Act(Split))
+	assert.Equal(RoundOver, game.Phase())
+
+	rules := DefaultRules()
+	rules.ResplitAces = true
+	game = stackedGame(rules, "Ac 6h Ad Ts Ah Kc 2d")
+	assert.NoError(game.Deal(10))
+	assert.NoError(game.Act(Split))
+	assert.Equal([]Action{Stand, Split}, game.Actions())
+}
+
+func TestGameDealerBlackjack(t *testing.T) {
+	assert := assert.New(t)
+	game := stackedGame(DefaultRules(), "Tc Kh 9d As")
+	assert.NoError(game.Deal(10))
+	assert.Equal(RoundOver, game.Phase())
+	assert.Equal(-10.0, game.Net(0))
+
+	game = stackedGame(DefaultRules(), "Tc Ah 9d Ks")
+	assert.NoError(game.Deal(10))
+	assert.NoError(game.Insure(0))
+	assert.IsType(&InvalidAction{}, game.Insure(0))
+	assert.NoError(game.Peek())
+	assert.Equal(RoundOver, game.Phase())
+	assert.Equal(10.0, game.InsuranceNet(0))
+	assert.Equal(0.0, game.Net(0))
+
+	rules := DefaultRules()
+	rules.DealerPeeks = false
+	rules.Insurance = false
+	game = stackedGame(rules, "8c Kh 8d As 3h Tc")
+	assert.NoError(game.Deal(10))
+	assert.NoError(game.Act(Split))
+	assert.NoError(game.Act(Double))
+	assert.NoError(game.Act(Stand))
+	assert.Equal(-30.0, game.Net(0))
+}
+
+func TestGameSurrender(t *testing.T) {
+	assert := assert.New(t)
+	game := stackedGame(DefaultRules(), "Tc Th 6d 7s")
+	assert.NoError(game.Deal(10))
+	assert.NoError(game.Act(Surrender))
+	assert.Equal(Surrendered, game.Hands()[0].Outcome())
+	assert.Equal(-5.0, game.Net(0))
+
+	rules := DefaultRules()
+	rules.Surrender = EarlySurrender
+	game = stackedGame(rules, "Tc Th As 6d 7c Kd")
+	assert.NoError(game.Deal(10, 10))
+	assert.Equal(Offers, game.Phase())
+	assert.NoError(game.SurrenderEarly(0))
+	assert.IsType(&InvalidSeat{}, game.SurrenderEarly(2))
+	assert.NoError(game.Peek())
+	assert.Equal(RoundOver, game.Phase())
+	assert.Equal(-5.0, game.Net(0))
+	assert.Equal(-10.0, game.Net(1))
+
+	rules.Surrender = NoSurrender
+	game = stackedGame(rules, "Tc Th 6d 7s")
+	assert.NoError(game.Deal(10))
+	assert.IsType(&InvalidAction{}, game.Act(Surrender))
+}
+
+func TestGameErrors(t *testing.T) {
+	assert := assert.New(t)
+	rules := DefaultRules()
+	rules.Decks = 0
+	_, err := NewGame(rules)
+	assert.IsType(&InvalidRules{}, err)
+	rules = DefaultRules()
+	rules.Penetration = 1.5
+	_, err = NewGame(rules)
+	assert.IsType(&InvalidRules{}, err)
+	game, err := NewGame(DefaultRules())
+	assert.NoError(err)
+	assert.IsType(&InvalidAction{}, game.Act(Hit))
+	assert.IsType(&InvalidAction{}, game.Peek())
+	assert.IsType(&InvalidBet{}, game.Deal())
+	assert.IsType(&InvalidBet{}, game.Deal(10, -5))
+	assert.Nil(game.Current())
+	assert.Nil(game.Actions())
+}
+
+func TestGameEmptyShoe(t *testing.T) {
+	assert := assert.New(t)
+	bets := make([]float64, 26)
+	for i := range bets {
+		bets[i] = 10
+	}
+	//27 hands of two cards need more than one deck.
+	game := stackedGame(DefaultRules(), "")
+	assert.IsType(&EmptyShoe{}, game.Deal(bets...))
+	assert.Empty(game.Hands())
+	assert.Equal(52, game.Shoe().CardCount()+game.Shoe().DiscardCount())
+
+	//Only the four cards dealt are left, the seat holds 2c 2s against the dealer's Ac.
+	game = stackedGame(DefaultRules(), "2c Ac 2s 7d")
+	rest := make([]int, 48)
+	for i := range rest {
+		rest[i] = i + 4
+	}
+	_, err := game.Shoe().Pick(rest)
+	assert.NoError(err)
+	assert.NoError(game.Deal(10))
+	assert.Equal(0, game.Shoe().CardCount())
+	assert.NoError(game.Peek())
+	assert.Equal(PlayerTurns, game.Phase())
+	assert.IsType(&EmptyShoe{}, game.Act(Hit))
+	assert.IsType(&EmptyShoe{}, game.Act(Double))
+	assert.Equal(10.0, game.Current().Bet())
+	assert.Equal(2, game.Current().CardCount())
+}
+
+func TestGameManyRounds(t *testing.T) {
+	assert := assert.New(t)
+	rules := DefaultRules()
+	rules.Decks = 2
+	game, err := NewGame(rules)
+	assert.NoError(err)
+	for round := 0; round < 2000; round++ {
+		if !assert.NoError(game.Deal(10, 20, 30)) {
+			return
+		}
+		if game.Phase() == Offers {
+			assert.NoError(game.Peek())
+		}
+		for game.Phase() == PlayerTurns {
+			action := Stand
+			if total, _ := game.Current().Total(); total < 12 {
+				action = Hit
+			}
+			assert.NoError(game.Act(action))
+		}
+		assert.Equal(RoundOver, game.Phase())
+		onTable := len(game.DealerCards())
+		for _, hand := range game.Hands() {
+			assert.NotEqual(Pending, hand.Outcome())
+			onTable += hand.CardCount()
+		}
+		shoe := game.Shoe()
+		assert.Equal(104, shoe.CardCount()+shoe.DiscardCount()+onTable)
+	}
+}
@@ -0,0 +1,145 @@
+package blackjack
+
+import (
+	"github.com/anthonyrouseau/games/cards"
+)
+
+//maxHandSize is more cards than any hand can hold without busting.
+const maxHandSize = 22
+
+//Outcome is how a hand finished against the dealer.
+type Outcome int
+
+//Outcome values
+const (
+	//Pending hands have not been settled.
+	Pending Outcome = iota
+	Win
+	Lose
+	Push
+	//Blackjack is a winning blackjack paid at the BlackjackPayout.
+	Blackjack
+	Surrendered
+)
+
+var outcomeNames = map[Outcome]string{
+	Pending:     "Pending",
+	Win:         "Win",
+	Lose:        "Lose",
+	Push:        "Push",
+	Blackjack:   "Blackjack",
+	Surrendered: "Surrendered",
+}
+
+//String returns the name of the outcome.
+func (o Outcome) String() string {
+	if name, ok := outcomeNames[o]; ok {
+		return name
+	}
+	return "Unknown"
+}
+
+//Hand is a blackjack hand and its bet.
+//
+//The card handling methods of a Hand come from its cards.Hand.
+type Hand struct {
+	cards.Hand
+	seat        int
+	bet         float64
+	net         float64
+	outcome     Outcome
+	split       bool
+	splitAces   bool
+	doubled     bool
+	surrendered bool
+	finished    bool
+}
+
+func newHand(seat int, bet float64) *Hand {
+	pile, _ := cards.NewPile(maxHandSize)
+	return &Hand{Hand: cards.Hand{Pile: pile}, seat: seat, bet: bet}
+}
+
+//CardValue returns the blackjack value of a rank, 1 for an Ace and 10 for a face card.
+func CardValue(rank cards.Rank) int {
+	if rank >= cards.Ten && rank <= cards.King {
+		return 10
+	}
+	if rank > cards.King {
+		return 0
+	}
+	return int(rank)
+}
+
+//Total returns the best total of the cards and whether it is soft.
+//
+//A total is soft when it counts an Ace as 11.
+func Total(cs []cards.Card) (int, bool) {
+	total := 0
+	aces := false
+	for _, card := range cs {
+		total += CardValue(card.Rank())
+		aces = aces || card.Rank() == cards.Ace
+	}
+	if aces && total+10 <= 21 {
+		return total + 10, true
+	}
+	return total, false
+}
+
+//Total returns the best total of the hand and whether it is soft.
+func (h *Hand) Total() (int, bool) {
+	return Total(h.Cards())
+}
+
+//IsBlackjack returns true if the hand is an Ace and a ten valued card that were not split.
+func (h *Hand) IsBlackjack() bool {
+	total, _ := h.Total()
+	return total == 21 && h.CardCount() == 2 && !h.split
+}
+
+//IsBust returns true if the hand totals more than 21.
+func (h *Hand) IsBust() bool {
+	total, _ := h.Total()
+	return total > 21
+}
+
+//Seat returns the seat playing the hand.
+func (h *Hand) Seat() int {
+	return h.seat
+}
+
+//Bet returns the amount bet on the hand including any double.
+func (h *Hand) Bet() float64 {
+	return h.bet
+}
+
+//Outcome returns how the hand finished, Pending until the round is over.
+func (h *Hand) Outcome() Outcome {
+	return h.outcome
+}
+
+//Net returns the amount won, or lost if negative, by the hand once the round is over.
+func (h *Hand) Net() float64 {
+	return h.net
+}
+
+//IsSplit returns true if the hand was split or made by splitting.
+func (h *Hand) IsSplit() bool {
+	return h.split
+}
+
+//IsDoubled returns true if the hand was doubled down.
+func (h *Hand) IsDoubled() bool {
+	return h.doubled
+}
+
+//IsSurrendered returns true if the hand was surrendered.
+func (h *Hand) IsSurrendered() bool {
+	return h.surrendered
+}
+
+//add places a card at the bottom of the hand.
+func (h *Hand) add(card cards.Card) {
+	h.PlaceBottom(card)
+}
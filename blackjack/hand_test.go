@@ -0,0 +1,64 @@
+package blackjack
+
+import (
+	"testing"
+
+	"github.com/anthonyrouseau/games/cards"
+	"github.com/stretchr/testify/assert"
+)
+
+func mustParse(notation string) []cards.Card {
+	cs, err := cards.ParseCards(notation)
+	if err != nil {
+		panic(err)
+	}
+	return cs
+}
+
+func TestTotal(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		hand  string
+		total int
+		soft  bool
+	}{
+		{"Ah 6d", 17, true},
+		{"Ah 6d Tc", 17, false},
+		{"Ah Ad 9c", 21, true},
+		{"Kh Qd 5c", 25, false},
+		{"Ah Kd", 21, true},
+		{"Ah Ad Ac As", 14, true},
+		{"", 0, false},
+	}
+	for _, test := range tests {
+		total, soft := Total(mustParse(test.hand))
+		assert.Equal(test.total, total, test.hand)
+		assert.Equal(test.soft, soft, test.hand)
+	}
+}
+
+func TestCardValue(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(1, CardValue(cards.Ace))
+	assert.Equal(9, CardValue(cards.Nine))
+	assert.Equal(10, CardValue(cards.Ten))
+	assert.Equal(10, CardValue(cards.King))
+	assert.Equal(0, CardValue(cards.BigJoker))
+}
+
+func TestHand(t *testing.T) {
+	assert := assert.New(t)
+	hand := newHand(0, 10)
+	for _, card := range mustParse("Ah Kd") {
+		hand.add(card)
+	}
+	assert.True(hand.IsBlackjack())
+	assert.False(hand.IsBust())
+	hand.split = true
+	assert.False(hand.IsBlackjack())
+	hand.add(mustParse("Qc")[0])
+	hand.add(mustParse("5c")[0])
+	assert.True(hand.IsBust())
+	assert.Equal(Pending, hand.Outcome())
+	assert.Equal("Pending", hand.Outcome().String())
+}
@@ -0,0 +1,139 @@
+package blackjack
+
+import (
+	"github.com/anthonyrouseau/games/cards"
+)
+
+//DoubleRule limits which two card hands may double down.
+type DoubleRule int
+
+//DoubleRule values
+const (
+	//DoubleAny allows doubling any two cards.
+	DoubleAny DoubleRule = iota
+	//DoubleNineToEleven allows doubling totals of 9, 10 and 11.
+	DoubleNineToEleven
+	//DoubleTenToEleven allows doubling totals of 10 and 11.
+	DoubleTenToEleven
+)
+
+//SurrenderRule is when a player may give up half their bet instead of playing a hand.
+type SurrenderRule int
+
+//SurrenderRule values
+const (
+	//NoSurrender does not allow surrender.
+	NoSurrender SurrenderRule = iota
+	//LateSurrender allows surrender once the dealer has checked for blackjack.
+	LateSurrender
+	//EarlySurrender also allows surrender before the dealer checks for blackjack.
+	EarlySurrender
+)
+
+//Rules are the house rules of a blackjack table.
+type Rules struct {
+	//Decks is the number of decks in the shoe.
+	Decks int
+	//Penetration is the fraction of the shoe dealt before it is reshuffled.
+	Penetration float64
+	//HitSoft17 makes the dealer hit a soft 17 rather than stand.
+	HitSoft17 bool
+	//DealerPeeks makes the dealer check for blackjack when showing an Ace or ten,
+	//without it players lose every bet, including doubles and splits, to a dealer blackjack.
+	DealerPeeks bool
+	//BlackjackPayout is what a blackjack pays per unit bet e.g. 1.5 for 3:2 or 1.2 for 6:5.
+	BlackjackPayout float64
+	Double          DoubleRule
+	//DoubleAfterSplit allows doubling hands made by splitting.
+	DoubleAfterSplit bool
+	//MaxHands is the most hands a seat can split into, 1 does not allow splitting.
+	MaxHands int
+	//ResplitAces allows splitting Aces again.
+	ResplitAces bool
+	//HitSplitAces allows playing split Aces, otherwise they are dealt one card each.
+	HitSplitAces bool
+	Surrender    SurrenderRule
+	//Insurance offers a side bet against a dealer blackjack when the dealer shows an Ace.
+	Insurance bool
+}
+
+//DefaultRules returns common rules for a six deck shoe.
+//
+//The dealer stands on soft 17 and peeks for blackjack which pays 3:2.
+//Players may double any two cards including after splits, split to four hands,
+//late surrender and take insurance.
+func DefaultRules() Rules {
+	return Rules{
+		Decks:            6,
+		Penetration:      0.75,
+		DealerPeeks:      true,
+		BlackjackPayout:  1.5,
+		Double:           DoubleAny,
+		DoubleAfterSplit: true,
+		MaxHands:         4,
+		Surrender:        LateSurrender,
+		Insurance:        true,
+	}
+}
+
+//validate returns an error for the first rule that can not be used.
+func (r Rules) validate() error {
+	switch {
+	case r.Decks < 1:
+		return &InvalidRules{rule: "Decks"}
+	case r.Penetration <= 0 || r.Penetration > 1:
+		return &InvalidRules{rule: "Penetration"}
+	case r.BlackjackPayout <= 0:
+		return &InvalidRules{rule: "BlackjackPayout"}
+	case r.Double < DoubleAny || r.Double > DoubleTenToEleven:
+		return &InvalidRules{rule: "Double"}
+	case r.MaxHands < 1:
+		return &InvalidRules{rule: "MaxHands"}
+	case r.Surrender < NoSurrender || r.Surrender > EarlySurrender:
+		return &InvalidRules{rule: "Surrender"}
+	}
+	return nil
+}
+
+//actions returns the actions the rules allow on a hand, ignoring how many hands its seat already plays.
+func (r Rules) actions(hand *Hand) []Action {
+	actions := []Action{Stand}
+	if !hand.splitAces || r.HitSplitAces {
+		actions = append(actions, Hit)
+	}
+	if r.canDouble(hand) {
+		actions = append(actions, Double)
+	}
+	if r.canSplit(hand) {
+		actions = append(actions, Split)
+	}
+	if r.Surrender != NoSurrender && hand.CardCount() == 2 && !hand.split {
+		actions = append(actions, Surrender)
+	}
+	return actions
+}
+
+func (r Rules) canDouble(hand *Hand) bool {
+	if hand.CardCount() != 2 || (hand.split && !r.DoubleAfterSplit) || (hand.splitAces && !r.HitSplitAces) {
+		return false
+	}
+	total, _ := hand.Total()
+	switch r.Double {
+	case DoubleNineToEleven:
+		return total >= 9 && total <= 11
+	case DoubleTenToEleven:
+		return total >= 10 && total <= 11
+	}
+	return true
+}
+
+func (r Rules) canSplit(hand *Hand) bool {
+	if hand.CardCount() != 2 || r.MaxHands < 2 {
+		return false
+	}
+	pair := hand.Cards()
+	if CardValue(pair[0].Rank()) != CardValue(pair[1].Rank()) {
+		return false
+	}
+	return !(hand.splitAces && pair[0].Rank() == cards.Ace && !r.ResplitAces)
+}
@@ -0,0 +1,197 @@
+package blackjack
+
+import (
+	"github.com/anthonyrouseau/games/cards"
+)
+
+//play is an entry of a basic strategy chart.
+type play int
+
+const (
+	hit play = iota
+	stand
+	//doubleOrHit doubles when allowed, otherwise hits.
+	doubleOrHit
+	//doubleOrStand doubles when allowed, otherwise stands.
+	doubleOrStand
+)
+
+//Advise returns the basic strategy action for a hand against the dealer's up card under the rules.
+//
+//The advice follows the multi-deck chart adjusted for whether the dealer hits soft 17,
+//doubling after splits and the doubling and surrender rules,
+//and only gives actions the rules allow on the hand.
+//Basic strategy never takes insurance, see AdviseEarlySurrender for early surrender.
+func Advise(hand *Hand, up cards.Card, rules Rules) Action {
+	return advise(hand, up, rules, rules.actions(hand))
+}
+
+//Advice returns the basic strategy action for the current hand, 0 outside the PlayerTurns phase.
+func (g *Game) Advice() Action {
+	if g.phase != PlayerTurns {
+		return 0
+	}
+	return advise(g.hands[g.current], g.DealerUpCard(), g.rules, g.Actions())
+}
+
+//AdviseEarlySurrender returns true if basic strategy surrenders the hand before the dealer checks for blackjack.
+func AdviseEarlySurrender(hand *Hand, up cards.Card, rules Rules) bool {
+	if rules.Surrender != EarlySurrender || hand.CardCount() != 2 || hand.split {
+		return false
+	}
+	total, soft := hand.Total()
+	if soft {
+		return false
+	}
+	pair := hand.Cards()
+	eights := pair[0].Rank() == cards.Eight && pair[1].Rank() == cards.Eight
+	switch upValue(up) {
+	case 11:
+		return (total >= 5 && total <= 7) || (total >= 12 && total <= 17)
+	case 10:
+		return total >= 14 && total <= 16
+	case 9:
+		return total == 16 && !eights
+	}
+	return false
+}
+
+func advise(hand *Hand, up cards.Card, rules Rules, actions []Action) Action {
+	allowed := map[Action]bool{}
+	for _, action := range actions {
+		allowed[action] = true
+	}
+	dealer := upValue(up)
+	total, soft := hand.Total()
+	pair := allowed[Split]
+	if allowed[Surrender] && !soft && shouldSurrender(total, pair, dealer, rules) {
+		return Surrender
+	}
+	if pair && shouldSplit(CardValue(hand.Cards()[0].Rank()), dealer, rules) {
+		return Split
+	}
+	if !allowed[Hit] {
+		return Stand
+	}
+	var p play
+	if soft {
+		p = softPlay(total, dealer, rules)
+	} else {
+		p = hardPlay(total, dealer, rules)
+	}
+	switch {
+	case p == stand:
+		return Stand
+	case p == hit:
+		return Hit
+	case allowed[Double]:
+		return Double
+	case p == doubleOrStand:
+		return Stand
+	}
+	return Hit
+}
+
+//upValue returns the value of the dealer's up card counting an Ace as 11.
+func upValue(up cards.Card) int {
+	if up.Rank() == cards.Ace {
+		return 11
+	}
+	return CardValue(up.Rank())
+}
+
+func hardPlay(total, dealer int, rules Rules) play {
+	switch {
+	case total >= 17:
+		return stand
+	case total >= 13:
+		if dealer <= 6 {
+			return stand
+		}
+	case total == 12:
+		if dealer >= 4 && dealer <= 6 {
+			return stand
+		}
+	case total == 11:
+		if dealer <= 10 || rules.HitSoft17 {
+			return doubleOrHit
+		}
+	case total == 10:
+		if dealer <= 9 {
+			return doubleOrHit
+		}
+	case total == 9:
+		if dealer >= 3 && dealer <= 6 {
+			return doubleOrHit
+		}
+	}
+	return hit
+}
+
+func softPlay(total, dealer int, rules Rules) play {
+	switch {
+	case total >= 20:
+		return stand
+	case total == 19:
+		if dealer == 6 && rules.HitSoft17 {
+			return doubleOrStand
+		}
+		return stand
+	case total == 18:
+		if dealer >= 3 && dealer <= 6 || (dealer == 2 && rules.HitSoft17) {
+			return doubleOrStand
+		}
+		if dealer <= 8 {
+			return stand
+		}
+	case total == 17:
+		if dealer >= 3 && dealer <= 6 {
+			return doubleOrHit
+		}
+	case total >= 15:
+		if dealer >= 4 && dealer <= 6 {
+			return doubleOrHit
+		}
+	case total >= 13:
+		if dealer >= 5 && dealer <= 6 {
+			return doubleOrHit
+		}
+	}
+	return hit
+}
+
+//shouldSplit returns true if a pair of the card value is split against the dealer.
+func shouldSplit(value, dealer int, rules Rules) bool {
+	das := rules.DoubleAfterSplit
+	switch value {
+	case 1, 8:
+		return true
+	case 9:
+		return dealer <= 9 && dealer != 7
+	case 7:
+		return dealer <= 7
+	case 6:
+		return dealer <= 6 && (das || dealer >= 3)
+	case 4:
+		return das && (dealer == 5 || dealer == 6)
+	case 2, 3:
+		return dealer <= 7 && (das || dealer >= 4)
+	}
+	return false
+}
+
+//shouldSurrender returns true if a hard total is surrendered against the dealer once they have checked for blackjack.
+func shouldSurrender(total int, pair bool, dealer int, rules Rules) bool {
+	if pair {
+		return total == 16 && dealer == 11 && rules.HitSoft17
+	}
+	switch total {
+	case 17:
+		return dealer == 11 && rules.HitSoft17
+	case 16:
+		return dealer >= 9
+	case 15:
+		return dealer == 10 || (dealer == 11 && rules.HitSoft17)
+	}
+	return false
+}
@@ -0,0 +1,112 @@
+package blackjack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//handOf returns an unsplit hand holding the cards.
+func handOf(notation string) *Hand {
+	hand := newHand(0, 10)
+	for _, card := range mustParse(notation) {
+		hand.add(card)
+	}
+	return hand
+}
+
+func TestAdvise(t *testing.T) {
+	assert := assert.New(t)
+	s17 := DefaultRules()
+	h17 := DefaultRules()
+	h17.HitSoft17 = true
+	noDAS := DefaultRules()
+	noDAS.DoubleAfterSplit = false
+	tests := []struct {
+		hand   string
+		up     string
+		rules  Rules
+		action Action
+	}{
+		{"Tc 7d", "Ah", s17, Stand},
+		{"Tc 6d", "6h", s17, Stand},
+		{"Tc 6d", "7h", s17, Hit},
+		{"Tc 6d", "Th", s17, Surrender},
+		{"Tc 5d", "Th", s17, Surrender},
+		{"Tc 5d", "Ah", s17, Hit},
+		{"Tc 5d", "Ah", h17, Surrender},
+		{"Tc 2d", "3h", s17, Hit},
+		{"Tc 2d", "4h", s17, Stand},
+		{"6c 5d", "Ah", s17, Hit},
+		{"6c 5d", "Ah", h17, Double},
+		{"6c 4d", "9h", s17, Double},
+		{"6c 4d", "Th", s17, Hit},
+		{"5c 4d", "3h", s17, Double},
+		{"5c 4d", "2h", s17, Hit},
+		{"Ac 7d", "2h", s17, Stand},
+		{"Ac 7d", "2h", h17, Double},
+		{"Ac 7d", "9h", s17, Hit},
+		{"Ac 8d", "6h", h17, Double},
+		{"Ac 8d", "6h", s17, Stand},
+		{"Ac 2d", "5h", s17, Double},
+		{"Ac 2d", "4h", s17, Hit},
+		{"Ac Ad", "Ah", s17, Split},
+		{"8c 8d", "Th", s17, Split},
+		{"8c 8d", "Ah", h17, Surrender},
+		{"9c 9d", "7h", s17, Stand},
+		{"9c 9d", "8h", s17, Split},
+		{"Tc Kd", "6h", s17, Stand},
+		{"5c 5d", "9h", s17, Double},
+		{"4c 4d", "5h", s17, Split},
+		{"4c 4d", "5h", noDAS, Hit},
+		{"2c 2d", "2h", s17, Split},
+		{"2c 2d", "2h", noDAS, Hit},
+		{"6c 6d", "2h", noDAS, Hit},
+		{"5c 3d 2h", "6h", s17, Hit},
+		{"Ac 5d 2h", "4h", s17, Stand},
+		{"Ac 2d 4h", "Th", s17, Hit},
+		{"Ac 4d 2h", "4h", s17, Hit},
+		{"Tc 4d 2h", "Th", s17, Hit},
+	}
+	for _, test := range tests {
+		up := mustParse(test.up)[0]
+		assert.Equal(test.action, Advise(handOf(test.hand), up, test.rules), "%s vs %s", test.hand, test.up)
+	}
+}
+
+func TestAdviseRestrictions(t *testing.T) {
+	assert := assert.New(t)
+	rules := DefaultRules()
+	rules.Double = DoubleTenToEleven
+	rules.Surrender = NoSurrender
+	assert.Equal(Hit, Advise(handOf("5c 4d"), mustParse("4h")[0], rules))
+	assert.Equal(Hit, Advise(handOf("Tc 6d"), mustParse("Th")[0], rules))
+	assert.Equal(Stand, Advise(handOf("Ac 7d"), mustParse("4h")[0], rules))
+	rules.MaxHands = 1
+	assert.Equal(Hit, Advise(handOf("8c 8d"), mustParse("Th")[0], rules))
+}
+
+func TestAdviseEarlySurrender(t *testing.T) {
+	assert := assert.New(t)
+	rules := DefaultRules()
+	assert.False(AdviseEarlySurrender(handOf("Tc 6d"), mustParse("Ah")[0], rules))
+	rules.Surrender = EarlySurrender
+	assert.True(AdviseEarlySurrender(handOf("Tc 6d"), mustParse("Ah")[0], rules))
+	assert.True(AdviseEarlySurrender(handOf("4c 2d"), mustParse("Ah")[0], rules))
+	assert.False(AdviseEarlySurrender(handOf("Tc 8d"), mustParse("Ah")[0], rules))
+	assert.True(AdviseEarlySurrender(handOf("Tc 4d"), mustParse("Th")[0], rules))
+	assert.False(AdviseEarlySurrender(handOf("8c 8d"), mustParse("9h")[0], rules))
+	assert.False(AdviseEarlySurrender(handOf("Ac 5d"), mustParse("Ah")[0], rules))
+}
+
+func TestGameAdvice(t *testing.T) {
+	assert := assert.New(t)
+	game := stackedGame(DefaultRules(), "8c 6h 8d Ts 3h Kc Tc 9s")
+	assert.Equal(Action(0), game.Advice())
+	assert.NoError(game.Deal(10))
+	assert.Equal(Split, game.Advice())
+	assert.NoError(game.Act(game.Advice()))
+	assert.Equal(Double, game.Advice())
+	assert.NoError(game.Act(game.Advice()))
+	assert.Equal(Stand, game.Advice())
+}
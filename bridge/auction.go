@@ -0,0 +1,300 @@
+package bridge
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anthonyrouseau/games/cards"
+	"github.com/anthonyrouseau/games/tricks"
+)
+
+//Strain is the trump suit of a bid or NoTrump, in the order bids rank.
+type Strain int
+
+//Strain values
+const (
+	Clubs Strain = iota
+	Diamonds
+	Hearts
+	Spades
+	NoTrump
+)
+
+var strainNames = map[Strain]string{
+	Clubs:    "C",
+	Diamonds: "D",
+	Hearts:   "H",
+	Spades:   "S",
+	NoTrump:  "NT",
+}
+
+//String returns the short name of the strain e.g. "NT".
+func (s Strain) String() string {
+	if name, ok := strainNames[s]; ok {
+		return name
+	}
+	return "?"
+}
+
+//Suit returns the trump suit of the strain, tricks.NoTrump for NoTrump.
+func (s Strain) Suit() cards.SuitName {
+	switch s {
+	case Clubs:
+		return cards.Clubs
+	case Diamonds:
+		return cards.Diamonds
+	case Hearts:
+		return cards.Hearts
+	case Spades:
+		return cards.Spades
+	}
+	return tricks.NoTrump
+}
+
+//CallKind is the kind of call made in an auction.
+type CallKind int
+
+//CallKind values
+const (
+	PassCall CallKind = iota
+	BidCall
+	DoubleCall
+	RedoubleCall
+)
+
+//Call is a call made in an auction, only bids have a Level and Strain.
+type Call struct {
+	Kind   CallKind
+	Level  int
+	Strain Strain
+}
+
+//Calls other than bids.
+var (
+	Pass     = Call{Kind: PassCall}
+	Double   = Call{Kind: DoubleCall}
+	Redouble = Call{Kind: RedoubleCall}
+)
+
+//Bid returns the bid to take 6 more than level tricks in the strain.
+func Bid(level int, strain Strain) Call {
+	return Call{Kind: BidCall, Level: level, Strain: strain}
+}
+
+//String returns the call as written in PBN e.g. "1NT", "Pass", "X" or "XX".
+func (c Call) String() string {
+	switch c.Kind {
+	case PassCall:
+		return "Pass"
+	case DoubleCall:
+		return "X"
+	case RedoubleCall:
+		return "XX"
+	}
+	return fmt.Sprintf("%d%v", c.Level, c.Strain)
+}
+
+//ParseCall returns the call written as a bid e.g. "1NT", "1N" or "4s", or "Pass", "P", "X", "Dbl", "XX" or "Rdbl".
+//
+//Errors with InvalidNotation if s is not a call.
+func ParseCall(s string) (Call, error) {
+	upper := strings.ToUpper(strings.TrimSpace(s))
+	switch upper {
+	case "P", "PASS":
+		return Pass, nil
+	case "X", "D", "DBL":
+		return Double, nil
+	case "XX", "R", "RDBL":
+		return Redouble, nil
+	}
+	if len(upper) >= 2 && upper[0] >= '1' && upper[0] <= '7' {
+		for strain, name := range strainNames {
+			if upper[1:] == name || (strain == NoTrump && upper[1:] == "N") {
+				return Bid(int(upper[0]-'0'), strain), nil
+			}
+		}
+	}
+	return Call{}, &InvalidNotation{format: "call", text: s}
+}
+
+//higher returns true if the bid outranks the other bid.
+func (c Call) higher(other Call) bool {
+	return c.Level > other.Level || (c.Level == other.Level && c.Strain > other.Strain)
+}
+
+//Penalty is whether a contract was doubled or redoubled.
+type Penalty int
+
+//Penalty values
+const (
+	Undoubled Penalty = iota
+	Doubled
+	Redoubled
+)
+
+//Contract is the final bid of an auction and the player who plays it.
+//
+//A contract with Level 0 was passed out.
+type Contract struct {
+	Level    int
+	Strain   Strain
+	Penalty  Penalty
+	Declarer Seat
+}
+
+//PassedOut returns true if every player passed.
+func (c Contract) PassedOut() bool {
+	return c.Level == 0
+}
+
+//Dummy returns the declarer's partner whose cards are played by the declarer.
+func (c Contract) Dummy() Seat {
+	return c.Declarer.Partner()
+}
+
+//String returns the contract e.g. "4HX by South" or "Passed out".
+func (c Contract) String() string {
+	if c.PassedOut() {
+		return "Passed out"
+	}
+	return fmt.Sprintf("%d%v%s by %v", c.Level, c.Strain, strings.Repeat("X", int(c.Penalty)), c.Declarer)
+}
+
+//valid returns true if the contract can be played.
+func (c Contract) valid() bool {
+	return c.Level >= 1 && c.Level <= 7 && c.Strain >= Clubs && c.Strain <= NoTrump &&
+		c.Penalty >= Undoubled && c.Penalty <= Redoubled && c.Declarer.valid()
+}
+
+//Auction is the calls made in turn from the dealer to decide the contract.
+type Auction struct {
+	dealer Seat
+	calls  []Call
+}
+
+//NewAuction returns an auction with the dealer to call first.
+func NewAuction(dealer Seat) *Auction {
+	return &Auction{dealer: dealer}
+}
+
+//Dealer returns the seat that called first.
+func (a *Auction) Dealer() Seat {
+	return a.dealer
+}
+
+//Calls returns the calls made in order from the dealer.
+func (a *Auction) Calls() []Call {
+	return append([]Call{}, a.calls...)
+}
+
+//Turn returns the seat to call next.
+func (a *Auction) Turn() Seat {
+	return a.seat(len(a.calls))
+}
+
+//seat returns the seat making the call at an index.
+func (a *Auction) seat(index int) Seat {
+	return Seat((int(a.dealer) + index) % 4)
+}
+
+//Done returns true once four players have passed, or three in a row after a bid.
+func (a *Auction) Done() bool {
+	n := len(a.calls)
+	if n < 4 {
+		return false
+	}
+	for _, call := range a.calls[n-3:] {
+		if call.Kind != PassCall {
+			return false
+		}
+	}
+	return true
+}
+
+//Legal returns true if the call may be made next.
+//
+//A bid must outrank the last bid, a double must follow an opponent's bid and a redouble
+//an opponent's double, with any passes between.
+func (a *Auction) Legal(c Call) bool {
+	if a.Done() {
+		return false
+	}
+	last, index := a.lastCall()
+	opponent := index >= 0 && a.seat(index)%2 != a.Turn()%2
+	switch c.Kind {
+	case PassCall:
+		return true
+	case BidCall:
+		bid, _ := a.lastBid()
+		return c.Level >= 1 && c.Level <= 7 && c.Strain >= Clubs && c.Strain <= NoTrump && c.higher(bid)
+	case DoubleCall:
+		return opponent && last.Kind == BidCall
+	case RedoubleCall:
+		return opponent && last.Kind == DoubleCall
+	}
+	return false
+}
+
+//Call makes the next call.
+//
+//Errors with InvalidCall if the call is not legal.
+func (a *Auction) Call(c Call) error {
+	if !a.Legal(c) {
+		return &InvalidCall{call: c}
+	}
+	if c.Kind != BidCall {
+		c = Call{Kind: c.Kind}
+	}
+	a.calls = append(a.calls, c)
+	return nil
+}
+
+//Contract returns the contract once the auction is done.
+//
+//The declarer is the player of the partnership making the final bid who first bid its strain.
+//Returns false until the auction is done.
+func (a *Auction) Contract() (Contract, bool) {
+	if !a.Done() {
+		return Contract{}, false
+	}
+	bid, index := a.lastBid()
+	if index < 0 {
+		return Contract{}, true
+	}
+	contract := Contract{Level: bid.Level, Strain: bid.Strain, Declarer: a.seat(index)}
+	for _, call := range a.calls[index+1:] {
+		switch call.Kind {
+		case DoubleCall:
+			contract.Penalty = Doubled
+		case RedoubleCall:
+			contract.Penalty = Redoubled
+		}
+	}
+	for i, call := range a.calls {
+		if call.Kind == BidCall && call.Strain == bid.Strain && (a.seat(i) == contract.Declarer || a.seat(i) == contract.Dummy()) {
+			contract.Declarer = a.seat(i)
+			break
+		}
+	}
+	return contract, true
+}
+
+//lastCall returns the last call other than a pass and its index, -1 if there is none.
+func (a *Auction) lastCall() (Call, int) {
+	for i := len(a.calls) - 1; i >= 0; i-- {
+		if a.calls[i].Kind != PassCall {
+			return a.calls[i], i
+		}
+	}
+	return Pass, -1
+}
+
+//lastBid returns the last bid and its index, -1 if there is none.
+func (a *Auction) lastBid() (Call, int) {
+	for i := len(a.calls) - 1; i >= 0; i-- {
+		if a.calls[i].Kind == BidCall {
+			return a.calls[i], i
+		}
+	}
+	return Call{}, -1
+}
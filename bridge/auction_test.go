@@ -0,0 +1,91 @@
+package bridge
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//call makes each call in turn.
+func call(assert *assert.Assertions, a *Auction, notations ...string) {
+	for _, notation := range notations {
+		c, err := ParseCall(notation)
+		assert.NoError(err)
+		assert.NoError(a.Call(c), notation)
+	}
+}
+
+func TestParseCall(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		notation string
+		expected Call
+	}{
+		{"1NT", Bid(1, NoTrump)},
+		{"3n", Bid(3, NoTrump)},
+		{"4s", Bid(4, Spades)},
+		{"7C", Bid(7, Clubs)},
+		{"Pass", Pass},
+		{"p", Pass},
+		{"X", Double},
+		{"Dbl", Double},
+		{"XX", Redouble},
+	}
+	for _, test := range tests {
+		c, err := ParseCall(test.notation)
+		assert.NoError(err)
+		assert.Equal(test.expected, c)
+	}
+	for _, notation := range []string{"8S", "0H", "1Z", ""} {
+		_, err := ParseCall(notation)
+		assert.IsType(&InvalidNotation{}, err)
+	}
+	assert.Equal("2D", Bid(2, Diamonds).String())
+	assert.Equal("XX", Redouble.String())
+}
+
+func TestAuction(t *testing.T) {
+	assert := assert.New(t)
+	a := NewAuction(North)
+	call(assert, a, "1C")
+	assert.Equal(East, a.Turn())
+	assert.False(a.Legal(Bid(1, Clubs)))
+	assert.False(a.Legal(Redouble))
+	assert.True(a.Legal(Double))
+	call(assert, a, "1H", "1S", "X")
+	//North redoubles partner's Spades doubled by West.
+	assert.True(a.Legal(Redouble))
+	call(assert, a, "XX", "Pass", "Pass", "2H", "Pass")
+	//East can not double partner.
+	assert.False(a.Legal(Double))
+	call(assert, a, "4H", "Pass")
+	assert.IsType(&InvalidCall{}, a.Call(Bid(3, NoTrump)))
+	call(assert, a, "Pass")
+	_, done := a.Contract()
+	assert.False(done)
+	call(assert, a, "Pass")
+	assert.True(a.Done())
+	contract, done := a.Contract()
+	assert.True(done)
+	//East bid Hearts before West.
+	assert.Equal(Contract{Level: 4, Strain: Hearts, Declarer: East}, contract)
+	assert.Equal(West, contract.Dummy())
+	assert.Equal("4H by East", contract.String())
+	assert.IsType(&InvalidCall{}, a.Call(Pass))
+	assert.Len(a.Calls(), 13)
+
+	a = NewAuction(South)
+	call(assert, a, "Pass", "1NT", "Pass", "3NT", "X", "Pass", "Pass", "XX", "Pass", "Pass", "Pass")
+	contract, _ = a.Contract()
+	assert.Equal(Contract{Level: 3, Strain: NoTrump, Penalty: Redoubled, Declarer: West}, contract)
+	assert.Equal("3NTXX by West", contract.String())
+
+	a = NewAuction(West)
+	call(assert, a, "Pass", "Pass", "Pass")
+	assert.False(a.Done())
+	call(assert, a, "Pass")
+	contract, done = a.Contract()
+	assert.True(done)
+	assert.True(contract.PassedOut())
+	assert.Equal("Passed out", contract.String())
+}
@@ -0,0 +1,116 @@
+package bridge
+
+import (
+	"github.com/anthonyrouseau/games/cards"
+	"github.com/anthonyrouseau/games/tricks"
+)
+
+//Board is a numbered deal of duplicate bridge with its dealer and vulnerability.
+type Board struct {
+	number        int
+	dealer        Seat
+	vulnerability Vulnerability
+	hands         [4]*cards.Hand
+}
+
+//NewBoard returns the board with the hands of North, East, South and West in order
+//e.g. the hands returned by Deck.Deal(4, 13).
+//
+//The dealer and vulnerability follow the board number.
+//Errors if the number is less than 1 or the hands are not 13 cards each of a standard deck.
+func NewBoard(number int, hands []*cards.Hand) (*Board, error) {
+	if number < 1 {
+		return nil, &InvalidBoard{number: number}
+	}
+	if len(hands) != 4 {
+		return nil, &InvalidHands{}
+	}
+	var dealt cards.CardSet
+	b := &Board{number: number, dealer: BoardDealer(number), vulnerability: BoardVulnerability(number)}
+	for i, hand := range hands {
+		set := cards.NewCardSet(hand.Cards()...)
+		if hand.CardCount() != 13 || set.Count() != 13 || set&dealt != 0 {
+			return nil, &InvalidHands{}
+		}
+		for _, card := range hand.Cards() {
+			if card.Index() < 0 || card.Index() >= 52 {
+				return nil, &InvalidHands{}
+			}
+		}
+		dealt |= set
+		b.hands[i] = copyHand(hand)
+	}
+	return b, nil
+}
+
+//DealBoard returns the board with hands dealt from a shuffled standard deck.
+//
+//Errors if the number is less than 1.
+func DealBoard(number int) (*Board, error) {
+	deck := cards.NewStandardDeck(false)
+	deck.Shuffle()
+	hands, err := deck.Deal(4, 13)
+	if err != nil {
+		return nil, err
+	}
+	return NewBoard(number, hands)
+}
+
+//copyHand returns a hand holding the same cards.
+func copyHand(hand *cards.Hand) *cards.Hand {
+	pile, _ := cards.NewPile(hand.MaxSize(), hand.Cards()...)
+	return &cards.Hand{Pile: pile}
+}
+
+//Number returns the board number.
+func (b *Board) Number() int {
+	return b.number
+}
+
+//Dealer returns the seat that calls first.
+func (b *Board) Dealer() Seat {
+	return b.dealer
+}
+
+//Vulnerability returns which partnerships are vulnerable.
+func (b *Board) Vulnerability() Vulnerability {
+	return b.vulnerability
+}
+
+//Hand returns a copy of the cards dealt to the seat.
+func (b *Board) Hand(s Seat) *cards.Hand {
+	return copyHand(b.hands[s])
+}
+
+//Hands returns copies of the hands of North, East, South and West in order.
+func (b *Board) Hands() []*cards.Hand {
+	hands := make([]*cards.Hand, len(b.hands))
+	for i, hand := range b.hands {
+		hands[i] = copyHand(hand)
+	}
+	return hands
+}
+
+//Auction returns a new auction of the board starting with the dealer.
+func (b *Board) Auction() *Auction {
+	return NewAuction(b.dealer)
+}
+
+//Play returns the play of the contract with the board's hands.
+//
+//Players are numbered by Seat and the declarer plays the dummy's cards in the dummy's turn.
+//Errors if the contract is passed out or not valid.
+func (b *Board) Play(c Contract) (*tricks.Game, error) {
+	if !c.valid() {
+		return nil, &InvalidContract{contract: c}
+	}
+	return tricks.NewBridge(b.Hands(), int(c.Declarer), c.Strain.Suit())
+}
+
+//Score returns the score of the declarer's partnership for the contract taking the tricks
+//with the declarer's vulnerability on the board.
+//
+//Errors as Score.
+func (b *Board) Score(c Contract, tricks int) (int, error) {
+	return Score(c, b.vulnerability.Vulnerable(c.Declarer), tricks)
+}
@@ -0,0 +1,147 @@
+package bridge
+
+import (
+	"testing"
+
+	"github.com/anthonyrouseau/games/cards"
+	"github.com/anthonyrouseau/games/tricks"
+	"github.com/stretchr/testify/assert"
+)
+
+//suitDeal is a PBN deal giving North every Spade, East every Heart, South every Diamond and West every Club.
+const suitDeal = "N:AKQJT98765432... .AKQJT98765432.. ..AKQJT98765432. ...AKQJT98765432"
+
+func TestBoardNumbers(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		number        int
+		dealer        Seat
+		vulnerability Vulnerability
+	}{
+		{1, North, None},
+		{2, East, NorthSouth},
+		{3, South, EastWest},
+		{4, West, Both},
+		{5, North, NorthSouth},
+		{13, North, Both},
+		{16, West, EastWest},
+		{17, North, None},
+		{0, West, EastWest},
+		{-1, South, NorthSouth},
+		{-16, West, EastWest},
+	}
+	for _, test := range tests {
+		assert.Equal(test.dealer, BoardDealer(test.number))
+		assert.Equal(test.vulnerability, BoardVulnerability(test.number))
+	}
+	assert.True(NorthSouth.Vulnerable(South))
+	assert.False(NorthSouth.Vulnerable(West))
+}
+
+func TestNewBoard(t *testing.T) {
+	assert := assert.New(t)
+	b, err := DealBoard(6)
+	assert.NoError(err)
+	assert.Equal(East, b.Dealer())
+	assert.Equal(EastWest, b.Vulnerability())
+	assert.Equal(13, b.Hand(West).CardCount())
+	b.Hand(West).PickTop()
+	assert.Equal(13, b.Hand(West).CardCount())
+	assert.Equal(6, b.Number())
+
+	deck := cards.NewStandardDeck(false)
+	hands, _ := deck.Deal(4, 13)
+	_, err = NewBoard(0, hands)
+	assert.IsType(&InvalidBoard{}, err)
+	_, err = NewBoard(1, hands[:3])
+	assert.IsType(&InvalidHands{}, err)
+	hands[0] = hands[1]
+	_, err = NewBoard(1, hands)
+	assert.IsType(&InvalidHands{}, err)
+}
+
+func TestPBN(t *testing.T) {
+	assert := assert.New(t)
+	b, err := ParsePBN("[Event \"Club\"]\n[Board \"1\"]\n[Deal \"E:.AKQJT98765432.. ..AKQJT98765432. ...AKQJT98765432 AKQJT98765432...\"]")
+	assert.NoError(err)
+	assert.Equal(North, b.Dealer())
+	assert.Equal(None, b.Vulnerability())
+	spades, _ := cards.ParseCards("As Ks Qs Js Ts 9s 8s 7s 6s 5s 4s 3s 2s")
+	assert.Equal(spades, b.Hand(North).Cards())
+	assert.Equal("[Board \"1\"]\n[Dealer \"N\"]\n[Vulnerable \"None\"]\n[Deal \""+suitDeal+"\"]\n", b.PBN())
+
+	dealt, _ := DealBoard(7)
+	read, err := ParsePBN(dealt.PBN())
+	assert.NoError(err)
+	assert.Equal(dealt.PBN(), read.PBN())
+	assert.Equal(South, read.Dealer())
+	assert.Equal(Both, read.Vulnerability())
+
+	read, err = ParsePBN("[Board \"7\"]\n[Dealer \"W\"]\n[Vulnerable \"NS\"]\n[Deal \"" + suitDeal + "\"]")
+	assert.NoError(err)
+	assert.Equal(West, read.Dealer())
+	assert.Equal(NorthSouth, read.Vulnerability())
+
+	invalid := []string{
+		"[Deal \"" + suitDeal + "\"]",
+		"[Board \"1\"]\n[Deal \"N:AKQJT98765432... .AKQJT98765432..\"]",
+		"[Board \"1\"]\n[Deal \"N:AKQJT98765432... .AKQJT98765432.. ..AKQJT98765432. ...AKQJT9876543A\"]",
+		"[Board \"1\"]\n[Vulnerable \"Some\"]\n[Deal \"" + suitDeal + "\"]",
+	}
+	for _, text := range invalid {
+		_, err := ParsePBN(text)
+		assert.IsType(&InvalidNotation{}, err, text)
+	}
+}
+
+func TestLIN(t *testing.T) {
+	assert := assert.New(t)
+	b, _ := ParsePBN("[Board \"1\"]\n[Deal \"" + suitDeal + "\"]")
+	lin := "md|3SHDAKQJT98765432C,SHDCAKQJT98765432,SAKQJT98765432HDC,SHAKQJT98765432DC|sv|o|ah|Board 1|"
+	assert.Equal(lin, b.LIN())
+
+	read, err := ParseLIN("pn|a,b,c,d|st||md|4SHDAKQJT98765432C,SHDCAKQJT98765432,SAKQJT98765432HDC,|rh||ah|Board 12|sv|b|mb|p|")
+	assert.NoError(err)
+	assert.Equal(12, read.Number())
+	assert.Equal(East, read.Dealer())
+	assert.Equal(Both, read.Vulnerability())
+	hearts, _ := cards.ParseCards("Ah Kh Qh Jh Th 9h 8h 7h 6h 5h 4h 3h 2h")
+	assert.ElementsMatch(hearts, read.Hand(East).Cards())
+
+	dealt, _ := DealBoard(9)
+	read, err = ParseLIN(dealt.LIN())
+	assert.NoError(err)
+	assert.Equal(dealt.PBN(), read.PBN())
+
+	read, err = ParseLIN("qx|o3|md|1SHDAKQJT98765432C,SHDCAKQJT98765432,SAKQJT98765432HDC|")
+	assert.NoError(err)
+	assert.Equal(3, read.Number())
+	assert.Equal(EastWest, read.Vulnerability())
+
+	for _, text := range []string{"md|3SAK|ah|Board 1|", "ah|Board 1|", "md|3SHDAKQJT98765432C,SHDCAKQJT98765432,SAKQJT98765432HDC,|"} {
+		_, err := ParseLIN(text)
+		assert.IsType(&InvalidNotation{}, err, text)
+	}
+}
+
+func TestBoardPlay(t *testing.T) {
+	assert := assert.New(t)
+	b, _ := ParsePBN("[Board \"1\"]\n[Deal \"" + suitDeal + "\"]")
+	contract := Contract{Level: 7, Strain: Spades, Declarer: North}
+	g, err := b.Play(contract)
+	assert.NoError(err)
+	assert.Equal(int(East), g.Turn())
+	for g.Phase() == tricks.Playing {
+		player := g.Turn()
+		assert.NoError(g.Play(player, g.Legal(player)[0]))
+	}
+	taken := g.TricksTaken(int(North)) + g.TricksTaken(int(South))
+	assert.Equal(13, taken)
+	score, err := b.Score(contract, taken)
+	assert.NoError(err)
+	assert.Equal(1510, score)
+	assert.Equal(13, b.Hand(North).CardCount())
+
+	_, err = b.Play(Contract{})
+	assert.IsType(&InvalidContract{}, err)
+}
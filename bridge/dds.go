@@ -0,0 +1,849 @@
+package bridge
+
+import (
+	"math/bits"
+	"sync"
+
+	"github.com/anthonyrouseau/games/cards"
+)
+
+//TrickTable is the number of tricks each seat takes as declarer in each strain playing double dummy,
+//indexed by Strain then Seat.
+type TrickTable [5][4]int
+
+//DoubleDummy returns the tricks each seat takes as declarer in each strain
+//when every player can see every card and plays perfectly.
+//
+//Each strain is solved in its own goroutine.
+func (b *Board) DoubleDummy() TrickTable {
+	var table TrickTable
+	var wg sync.WaitGroup
+	for strain := Clubs; strain <= NoTrump; strain++ {
+		wg.Add(1)
+		go func(strain Strain) {
+			defer wg.Done()
+			s := newDDSolver(b.hands[:], strain)
+			//The tricks with one seat on lead are a good guess for the next,
+			//which is most often right when the partner of the last leader leads.
+			guess := -1
+			for _, declarer := range []Seat{North, South, East, West} {
+				guess = s.solve(int(declarer.Next()), guess)
+				table[strain][declarer] = declarerTricks(declarer, guess, 13)
+			}
+		}(strain)
+	}
+	wg.Wait()
+	return table
+}
+
+//DoubleDummyTricks returns the tricks the declarer takes in the strain from the hands of North, East, South
+//and West when every player can see every card and plays perfectly, with the declarer's left hand opponent on lead.
+//
+//The hands may be any equal size to solve endings.
+//Errors if the strain or declarer is not valid,
+//there are not 4 hands of the same size or a card is repeated or not a standard card.
+func DoubleDummyTricks(hands []*cards.Hand, strain Strain, declarer Seat) (int, error) {
+	if c := (Contract{Level: 1, Strain: strain, Declarer: declarer}); !c.valid() {
+		return 0, &InvalidContract{contract: c}
+	}
+	if len(hands) != 4 {
+		return 0, &InvalidHands{}
+	}
+	var dealt cards.CardSet
+	for _, hand := range hands {
+		set := cards.NewCardSet(hand.Cards()...)
+		if hand.CardCount() != hands[0].CardCount() || set.Count() != hand.CardCount() || set&dealt != 0 {
+			return 0, &InvalidHands{}
+		}
+		for _, card := range hand.Cards() {
+			if card.Index() < 0 || card.Index() >= 52 {
+				return 0, &InvalidHands{}
+			}
+		}
+		dealt |= set
+	}
+	tricks := newDDSolver(hands, strain).solve(int(declarer.Next()), -1)
+	return declarerTricks(declarer, tricks, hands[0].CardCount()), nil
+}
+
+//declarerTricks returns the tricks the declarer's side takes from the tricks North and South take of total.
+func declarerTricks(declarer Seat, tricks, total int) int {
+	if declarer == North || declarer == South {
+		return tricks
+	}
+	return total - tricks
+}
+
+//ddPlay is a card played to a trick by suit, in the order of Strain, and rank from 0 for a Two to 12 for an Ace.
+type ddPlay struct {
+	player, suit, rank int
+}
+
+//ddShape is the number of cards each player holds in each suit at the start of a trick, four bits each,
+//with the leader in place of the number of West's Spades which the others give.
+type ddShape uint64
+
+//ddTree is the transposition table of a shape, which compares one suit at a time from Clubs.
+//
+//A node below the root matches positions where the count highest cards of its suit are held by the same players
+//as the owners of the edge leading to it, two bits each from the highest card.
+//The rest of the cards never won a trick by their rank so any cards held by the same players do as well.
+//Nodes of the last suit hold the fewest and most tricks North and South take from the positions matched
+//and every node holds the most of the fewest and the fewest of the most below it.
+//
+//The edges are kept by key with open addressing.
+type ddTree struct {
+	nodes []ddNode
+	edges []ddEdge
+}
+
+//ddEdge leads from a node to the next, with the key of edge.
+type ddEdge struct {
+	key  uint64
+	next int32
+}
+
+//ddNode is a node of a ddTree.
+type ddNode struct {
+	//counts has a bit set for the count of each node below.
+	counts       uint16
+	lower, upper int8
+}
+
+func newDDTree() *ddTree {
+	return &ddTree{nodes: []ddNode{{upper: 13}}, edges: make([]ddEdge, 8)}
+}
+
+//edge returns the key of the edge from the parent node to the node comparing the count highest of all the cards
+//of the next suit held by the owners.
+func edge(parent int32, owners uint32, count, all int) uint64 {
+	return uint64(parent+1)<<32 | uint64(owners>>uint(2*(all-count))<<4) | uint64(count)
+}
+
+//child returns the node an edge leads to, ok is false if there is none.
+func (t *ddTree) child(key uint64) (node int32, ok bool) {
+	mask := uint64(len(t.edges) - 1)
+	for i := key * 0x9E3779B97F4A7C15 >> 40 & mask; t.edges[i].key != 0; i = (i + 1) & mask {
+		if t.edges[i].key == key {
+			return t.edges[i].next, true
+		}
+	}
+	return 0, false
+}
+
+//grow adds a node at the end of an edge that is not in the tree yet.
+func (t *ddTree) grow(key uint64) int32 {
+	node := int32(len(t.nodes))
+	t.nodes = append(t.nodes, ddNode{upper: 13})
+	if 2*len(t.nodes) > len(t.edges) {
+		edges := t.edges
+		t.edges = make([]ddEdge, 2*len(edges))
+		for _, e := range edges {
+			if e.key != 0 {
+				t.set(e)
+			}
+		}
+	}
+	t.set(ddEdge{key: key, next: node})
+	return node
+}
+
+//set puts an edge in the first free slot from the one its key hashes to.
+func (t *ddTree) set(e ddEdge) {
+	mask := uint64(len(t.edges) - 1)
+	i := e.key * 0x9E3779B97F4A7C15 >> 40 & mask
+	for t.edges[i].key != 0 {
+		i = (i + 1) & mask
+	}
+	t.edges[i] = e
+}
+
+//find returns true if North and South can take target tricks from the position with the owners and counts of each suit
+//and the counts of the cards compared, ok is false if no node below the node answers it.
+func (t *ddTree) find(node int32, suit int, owners [4]uint32, all [4]int, target int, compared *[4]int) (won, ok bool) {
+	n := t.nodes[node]
+	if int(n.lower) < target && int(n.upper) >= target {
+		return false, false
+	}
+	if suit == 4 {
+		return int(n.lower) >= target, true
+	}
+	//Nodes comparing fewer cards are tried first so the positions found depend on as few cards as possible.
+	for counts := n.counts; counts != 0; counts &= counts - 1 {
+		count := bits.TrailingZeros16(counts)
+		next, found := t.child(edge(node, owners[suit], count, all[suit]))
+		if !found {
+			continue
+		}
+		if won, ok := t.find(next, suit+1, owners, all, target, compared); ok {
+			compared[suit] = count
+			return won, true
+		}
+	}
+	return false, false
+}
+
+//add remembers that North and South can take, or can not take if not won, target tricks
+//from the positions where the compared highest cards of each suit are held by the same players as owners.
+func (t *ddTree) add(owners [4]uint32, compared, all [4]int, target int, won bool) {
+	node := int32(0)
+	for suit := 0; ; suit++ {
+		n := &t.nodes[node]
+		if won && target > int(n.lower) {
+			n.lower = int8(target)
+		}
+		if !won && target-1 < int(n.upper) {
+			n.upper = int8(target - 1)
+		}
+		if suit == 4 {
+			return
+		}
+		key := edge(node, owners[suit], compared[suit], all[suit])
+		next, found := t.child(key)
+		if !found {
+			n.counts |= 1 << uint(compared[suit])
+			next = t.grow(key)
+		}
+		node = next
+	}
+}
+
+//ddSolver searches for the tricks North and South take in a strain.
+//
+//The search asks whether North and South can take a target number of tricks using alpha-beta
+//on that single question, remembering the bounds found for each position in a transposition table
+//shared by every target and leader. Positions are remembered by the lengths of the hands and only
+//the cards whose rank decided a trick so they also answer for positions differing in lower cards.
+//Cards of a player in sequence with no other remaining card between them are equivalent so only one of them is tried.
+//The table is also asked for the position each last card of a trick reaches before any of them is searched,
+//and for the position each lead reaches with the first card of the others to try the leads it favours first.
+type ddSolver struct {
+	hands [4][4]uint16
+	trump int
+	trick [4]ddPlay
+	table map[ddShape]*ddTree
+	//owners and lengths are kept up to date as cards are played for the position of each trick.
+	owners  [4]uint32
+	lengths uint64
+}
+
+func newDDSolver(hands []*cards.Hand, strain Strain) *ddSolver {
+	s := &ddSolver{trump: int(strain), table: map[ddShape]*ddTree{}}
+	if strain == NoTrump {
+		s.trump = -1
+	}
+	for player, hand := range hands {
+		for _, card := range hand.Cards() {
+			s.restore(player, suitIndex(card.Suit().Name()), rankOrder(card.Rank())-2)
+		}
+	}
+	return s
+}
+
+//suitIndex returns the index of a suit in the order of Strain.
+func suitIndex(suit cards.SuitName) int {
+	switch suit {
+	case cards.Clubs:
+		return int(Clubs)
+	case cards.Diamonds:
+		return int(Diamonds)
+	case cards.Hearts:
+		return int(Hearts)
+	}
+	return int(Spades)
+}
+
+//solve returns the tricks North and South take with the leader to lead.
+//
+//Targets are halved between the tricks known to be possible and impossible
+//but if guess is not negative the first targets are guess and the next trick either side of it.
+func (s *ddSolver) solve(leader, guess int) int {
+	lower, upper := 0, s.cardsLeft(leader)
+	for tests := 0; lower < upper; tests++ {
+		target := (lower + upper + 1) / 2
+		if guess >= 0 && tests < 2 {
+			target = guess
+			if target <= lower {
+				target = lower + 1
+			}
+			if target > upper {
+				target = upper
+			}
+		}
+		if won, _ := s.canTake(leader, target); won {
+			lower = target
+			guess = target + 1
+		} else {
+			upper = target - 1
+			guess = target - 1
+		}
+	}
+	return lower
+}
+
+//cardsLeft returns the number of cards the player holds.
+func (s *ddSolver) cardsLeft(player int) int {
+	count := 0
+	for _, suit := range s.hands[player] {
+		count += bitCount(suit)
+	}
+	return count
+}
+
+//remaining returns the ranks of the cards of a suit still held.
+func (s *ddSolver) remaining(suit int) uint16 {
+	return s.hands[0][suit] | s.hands[1][suit] | s.hands[2][suit] | s.hands[3][suit]
+}
+
+//canTake returns true if North and South can take target of the remaining tricks with the leader to lead
+//and the ranks of the cards the answer depends on.
+func (s *ddSolver) canTake(leader, target int) (bool, [4]uint16) {
+	if won, relevant, ok := s.known(leader, target); ok {
+		return won, relevant
+	}
+	return s.search(leader, target)
+}
+
+//search returns canTake for a position the sure tricks and the table do not answer by playing it out,
+//remembering the answer in the table.
+func (s *ddSolver) search(leader, target int) (bool, [4]uint16) {
+	shape, owners, counts := s.position(leader)
+	tree := s.table[shape]
+	if tree == nil {
+		tree = newDDTree()
+		s.table[shape] = tree
+	}
+	won, relevant := s.play(leader, 0, target)
+	var compared [4]int
+	for suit, ranks := range relevant {
+		if ranks != 0 {
+			//Every card above a card that decided a trick is compared too.
+			compared[suit] = bitCount(s.remaining(suit) >> uint(bits.TrailingZeros16(ranks)))
+		}
+	}
+	tree.add(owners, compared, counts, target, won)
+	return won, s.highest(compared)
+}
+
+//known returns true if North and South can take target of the remaining tricks with the leader to lead
+//and the ranks it depends on when the sure tricks or the transposition table answer it without searching,
+//ok is false otherwise.
+func (s *ddSolver) known(leader, target int) (won bool, relevant [4]uint16, ok bool) {
+	remaining := s.cardsLeft(leader)
+	if target <= 0 {
+		return true, relevant, true
+	}
+	if target > remaining {
+		return false, relevant, true
+	}
+	if won, relevant, ok := s.bounds(leader, target, remaining); ok {
+		return won, relevant, true
+	}
+	shape, owners, counts := s.position(leader)
+	if tree := s.table[shape]; tree != nil {
+		var compared [4]int
+		if won, ok := tree.find(0, 0, owners, counts, target, &compared); ok {
+			return won, s.highest(compared), true
+		}
+	}
+	return false, relevant, false
+}
+
+//bounds returns true if North and South can take target of the remaining tricks and the ranks it depends on
+//when the tricks one side is sure of answer it without searching, ok is false otherwise.
+func (s *ddSolver) bounds(leader, target, remaining int) (won bool, relevant [4]uint16, ok bool) {
+	//The side on lead needs target tricks if it is North and South, enough to stop them otherwise.
+	need := target
+	if leader%2 == 1 {
+		need = remaining - target + 1
+	}
+	if relevant, ok := s.quickTricks(leader, need); ok {
+		return leader%2 == 0, relevant, true
+	}
+	if trumps, player := s.sureTrumps(0); trumps >= target {
+		return true, s.trumped(player, target), true
+	}
+	if trumps, player := s.sureTrumps(1); trumps >= remaining-target+1 {
+		return false, s.trumped(player, remaining-target+1), true
+	}
+	return false, relevant, false
+}
+
+//position returns the shape of the position at the start of a trick with the owners of the cards of each suit,
+//two bits each from the highest card, and the number of cards left in each suit.
+func (s *ddSolver) position(leader int) (ddShape, [4]uint32, [4]int) {
+	var counts [4]int
+	for suit := range counts {
+		counts[suit] = bitCount(s.remaining(suit))
+	}
+	return ddShape(s.lengths&^0xf | uint64(leader)), s.owners, counts
+}
+
+//remove takes a card from the player's hand.
+func (s *ddSolver) remove(player, suit, rank int) {
+	//The owners of the cards below it move up.
+	below := 2 * uint(bitCount(s.remaining(suit)&(1<<uint(rank)-1)))
+	owners := s.owners[suit]
+	s.owners[suit] = owners>>(below+2)<<below | owners&(1<<below-1)
+	s.lengths -= 1 << uint(4*(15-4*suit-player))
+	s.hands[player][suit] &^= 1 << uint(rank)
+}
+
+//restore returns a removed card to the player's hand.
+func (s *ddSolver) restore(player, suit, rank int) {
+	below := 2 * uint(bitCount(s.remaining(suit)&(1<<uint(rank)-1)))
+	owners := s.owners[suit]
+	s.owners[suit] = (owners>>below<<2|uint32(player))<<below | owners&(1<<below-1)
+	s.lengths += 1 << uint(4*(15-4*suit-player))
+	s.hands[player][suit] |= 1 << uint(rank)
+}
+
+//highest returns the ranks of the count highest cards left in each suit.
+func (s *ddSolver) highest(counts [4]int) [4]uint16 {
+	var ranks [4]uint16
+	for suit, count := range counts {
+		ranks[suit] = s.remaining(suit)
+		for i := bitCount(ranks[suit]) - count; i > 0; i-- {
+			ranks[suit] &= ranks[suit] - 1
+		}
+	}
+	return ranks
+}
+
+//ddCash is the tricks a player could cash in each suit and the number of the highest cards of the suit they depend on.
+type ddCash struct {
+	tricks      int
+	suits, tops [4]int
+}
+
+//quickTricks returns the ranks need tricks of the leader's side depend on when it can take them straight away
+//by cashing winners in the leader's hand, after leading to winners of the partner or both, ok is false otherwise.
+func (s *ddSolver) quickTricks(leader, need int) (relevant [4]uint16, ok bool) {
+	partner := (leader + 2) % 4
+	//The side can only cash the suits it holds the highest card of.
+	most := 0
+	for suit := 0; suit < 4; suit++ {
+		held := s.hands[leader][suit] | s.hands[partner][suit]
+		if held != 0 && highestBit(held) == highestBit(s.remaining(suit)) {
+			longest := bitCount(s.hands[leader][suit])
+			if length := bitCount(s.hands[partner][suit]); length > longest {
+				longest = length
+			}
+			most += longest
+		}
+	}
+	if most < need {
+		return relevant, false
+	}
+	own := s.winners(leader)
+	if s.cashable(own, partner, ddCash{}, ddCash{}) >= need {
+		var counts [4]int
+		s.cash(&counts, leader, own, -1, need)
+		return s.highest(counts), true
+	}
+	theirs := s.winners(partner)
+	entry := -1
+	for suit := 0; suit < 4 && entry < 0; suit++ {
+		if theirs.suits[suit] > 0 && s.hands[leader][suit] != 0 {
+			entry = suit
+		}
+	}
+	if entry < 0 {
+		return relevant, false
+	}
+	var counts [4]int
+	if s.cashable(theirs, leader, ddCash{}, ddCash{}) >= need {
+		s.cash(&counts, partner, theirs, entry, need)
+		return s.highest(counts), true
+	}
+	//The partner keeps their winners while discarding on the leader's first.
+	if s.cashable(own, partner, theirs, ddCash{})+s.cashable(theirs, leader, ddCash{}, own) < need {
+		return relevant, false
+	}
+	s.cash(&counts, leader, own, -1, own.tricks)
+	s.cash(&counts, partner, theirs, entry, theirs.tricks)
+	return s.highest(counts), true
+}
+
+//winners returns the tricks the player could cash in each suit with cards higher than any the other players hold
+//and the rest of the suit once no other player has any left, only counting as many in a side suit
+//as an opponent with trumps can follow.
+func (s *ddSolver) winners(player int) ddCash {
+	left, partner, right := s.hands[(player+1)%4], s.hands[(player+2)%4], s.hands[(player+3)%4]
+	var cash ddCash
+	for suit := 0; suit < 4; suit++ {
+		//Higher cards held by the partner could take the lead away.
+		top := highestBit(left[suit] | partner[suit] | right[suit])
+		tops := bitCount(s.hands[player][suit] &^ (1<<uint(top+1) - 1))
+		if tops == 0 {
+			continue
+		}
+		tricks := tops
+		//The partner could overtake the rest of the suit until they have none left.
+		if tops >= bitCount(left[suit]) && tops >= bitCount(partner[suit]) && tops >= bitCount(right[suit]) {
+			tricks = bitCount(s.hands[player][suit])
+		}
+		if s.trump >= 0 && suit != s.trump {
+			for _, opponent := range [2][4]uint16{left, right} {
+				if opponent[s.trump] != 0 && bitCount(opponent[suit]) < tricks {
+					tricks = bitCount(opponent[suit])
+				}
+			}
+		}
+		cash.suits[suit] = tricks
+		cash.tops[suit] = tops
+		cash.tricks += tricks
+	}
+	return cash
+}
+
+//cashable returns how many of the tricks can be cashed while the partner follows or discards without having to trump,
+//which would take the lead away, keeping their cards of the suits they have winners in to cash later
+//and no longer holding the cards of the suits they cashed before.
+func (s *ddSolver) cashable(cash ddCash, partner int, keep, cashed ddCash) int {
+	hand := s.hands[partner]
+	if keep.tricks == 0 && (s.trump < 0 || hand[s.trump] == 0) {
+		return cash.tricks
+	}
+	tricks, discards, spare := 0, 0, 0
+	for suit := 0; suit < 4; suit++ {
+		follows := bitCount(hand[suit])
+		if follows > cash.suits[suit] {
+			follows = cash.suits[suit]
+		}
+		tricks += follows
+		discards += cash.suits[suit] - follows
+		if left := bitCount(hand[suit]) - follows - cashed.suits[suit]; left > 0 && suit != s.trump && keep.suits[suit] == 0 {
+			spare += left
+		}
+	}
+	if discards > spare {
+		discards = spare
+	}
+	return tricks + discards
+}
+
+//cash adds to counts the highest cards of each suit need of the player's tricks depend on,
+//from as few suits as possible starting with the first suit if it is not negative.
+//Suits no other player holds count on their length alone.
+func (s *ddSolver) cash(counts *[4]int, player int, cash ddCash, first, need int) {
+	take := func(suit int) {
+		tricks := cash.suits[suit]
+		if tricks > need {
+			tricks = need
+		}
+		need -= tricks
+		if tricks > cash.tops[suit] {
+			tricks = cash.tops[suit]
+		}
+		if tricks > counts[suit] && s.remaining(suit) != s.hands[player][suit] {
+			counts[suit] = tricks
+		}
+	}
+	if first >= 0 {
+		take(first)
+	}
+	for suit := 0; suit < 4 && need > 0; suit++ {
+		if suit != first && s.remaining(suit) == s.hands[player][suit] {
+			take(suit)
+		}
+	}
+	for suit := 0; suit < 4 && need > 0; suit++ {
+		if suit != first && s.remaining(suit) != s.hands[player][suit] {
+			take(suit)
+		}
+	}
+}
+
+//sureTrumps returns the tricks a side takes with the trumps in one of its hands higher than any the other side holds
+//and the player holding them.
+func (s *ddSolver) sureTrumps(side int) (int, int) {
+	if s.trump < 0 {
+		return 0, side
+	}
+	top := highestBit(s.hands[(side+1)%4][s.trump] | s.hands[(side+3)%4][s.trump])
+	tricks, holder := 0, side
+	for _, player := range []int{side, side + 2} {
+		if winners := bitCount(s.hands[player][s.trump] &^ (1<<uint(top+1) - 1)); winners > tricks {
+			tricks, holder = winners, player
+		}
+	}
+	return tricks, holder
+}
+
+//trumped returns the ranks need of the player's sure trumps depend on, the trumps down to the lowest of them used.
+func (s *ddSolver) trumped(player, need int) [4]uint16 {
+	var relevant [4]uint16
+	trumps := s.hands[player][s.trump]
+	if s.remaining(s.trump) == trumps|s.hands[(player+2)%4][s.trump] {
+		return relevant
+	}
+	for i := bitCount(trumps) - need; i > 0; i-- {
+		trumps &= trumps - 1
+	}
+	relevant[s.trump] = s.remaining(s.trump) &^ (1<<uint(bits.TrailingZeros16(trumps)) - 1)
+	return relevant
+}
+
+//play returns true if North and South can take target of the tricks left, counting the current trick,
+//with the player to play the count card of the trick and the ranks of the cards the answer depends on.
+func (s *ddSolver) play(player, count, target int) (bool, [4]uint16) {
+	if count == 4 {
+		leader, rest := s.taken(target)
+		//The next trick reuses the plays of this one.
+		//The sure tricks and the table were asked before the last card was played.
+		trick := s.trick
+		result, relevant := s.search(leader, rest)
+		s.trick = trick
+		s.decided(&relevant)
+		return result, relevant
+	}
+	maximizing := player%2 == 0
+	var buffer [13]ddMove
+	var relevant [4]uint16
+	moves := s.moves(player, count, buffer[:0])
+	if count == 0 && len(moves) > 1 && s.cardsLeft(player) >= ddGreedyCards {
+		//Leads that reach a position known to be good for the player's side are tried first.
+		promoted := 0
+		for i, m := range moves {
+			if s.greedy(player, m, target) {
+				copy(moves[promoted+1:i+1], moves[promoted:i])
+				moves[promoted] = m
+				promoted++
+			}
+		}
+	}
+	var searched [13]bool
+	if count == 3 {
+		//The last card of the trick reaches a trick start the table or sure tricks may already answer.
+		for i, m := range moves {
+			s.remove(player, m.suit, m.rank)
+			s.trick[count] = ddPlay{player: player, suit: m.suit, rank: m.rank}
+			leader, rest := s.taken(target)
+			result, r, ok := s.known(leader, rest)
+			s.restore(player, m.suit, m.rank)
+			if !ok {
+				continue
+			}
+			searched[i] = true
+			s.decided(&r)
+			if result == maximizing {
+				return result, r
+			}
+			for suit := range r {
+				relevant[suit] |= r[suit]
+			}
+		}
+	}
+	for i, m := range moves {
+		if searched[i] {
+			continue
+		}
+		s.remove(player, m.suit, m.rank)
+		s.trick[count] = ddPlay{player: player, suit: m.suit, rank: m.rank}
+		result, r := s.play((player+1)%4, count+1, target)
+		s.restore(player, m.suit, m.rank)
+		if result == maximizing {
+			return result, r
+		}
+		for suit := range r {
+			relevant[suit] |= r[suit]
+		}
+	}
+	//The cards skipped in a sequence are only equivalent to the one tried while the whole sequence is compared.
+	for _, m := range moves {
+		if relevant[m.suit]&m.sequence != 0 {
+			relevant[m.suit] |= 1 << uint(m.rank)
+		}
+	}
+	return !maximizing, relevant
+}
+
+//ddGreedyCards is the fewest cards in hand for which leads are ordered by playing out their trick,
+//below it searching the leads costs little more than ordering them.
+const ddGreedyCards = 6
+
+//greedy returns true if the trick after the player's lead, played with the first move of each of the others,
+//reaches a position known to be good for the player's side.
+func (s *ddSolver) greedy(player int, lead ddMove, target int) bool {
+	s.remove(player, lead.suit, lead.rank)
+	s.trick[0] = ddPlay{player: player, suit: lead.suit, rank: lead.rank}
+	var buffer [13]ddMove
+	for count := 1; count < 4; count++ {
+		next := (player + count) % 4
+		m := s.moves(next, count, buffer[:0])[0]
+		s.remove(next, m.suit, m.rank)
+		s.trick[count] = ddPlay{player: next, suit: m.suit, rank: m.rank}
+	}
+	leader, rest := s.taken(target)
+	won, _, ok := s.known(leader, rest)
+	for count := 3; count >= 0; count-- {
+		s.restore(s.trick[count].player, s.trick[count].suit, s.trick[count].rank)
+	}
+	return ok && won == (player%2 == 0)
+}
+
+//taken returns the player winning the finished trick, who leads the next,
+//and the tricks North and South need of the rest to take target counting it.
+func (s *ddSolver) taken(target int) (int, int) {
+	best := s.trick[s.winning(4)]
+	if best.player%2 == 0 {
+		return best.player, target - 1
+	}
+	return best.player, target
+}
+
+//decided adds to relevant the rank of the card winning the finished trick if another card of its suit was played to it.
+func (s *ddSolver) decided(relevant *[4]uint16) {
+	best := s.trick[s.winning(4)]
+	for _, p := range s.trick {
+		if p.player != best.player && p.suit == best.suit {
+			//The trick was won by the rank of the card.
+			relevant[best.suit] |= 1 << uint(best.rank)
+			return
+		}
+	}
+}
+
+//winning returns the index of the play winning the first count plays of the trick.
+func (s *ddSolver) winning(count int) int {
+	best := 0
+	for i := 1; i < count; i++ {
+		if s.beats(s.trick[i], s.trick[best]) {
+			best = i
+		}
+	}
+	return best
+}
+
+//beats returns true if a card beats the card winning a trick.
+func (s *ddSolver) beats(card, winning ddPlay) bool {
+	if card.suit == winning.suit {
+		return card.rank > winning.rank
+	}
+	return card.suit == s.trump
+}
+
+//ddMove is the lowest card of a sequence a player may play with the order it is tried in, highest first.
+type ddMove struct {
+	suit, rank, order int
+	sequence          uint16
+}
+
+//moves returns the cards the player may play to the trick, one of each sequence of equivalent cards,
+//in the order they are tried.
+func (s *ddSolver) moves(player, count int, moves []ddMove) []ddMove {
+	suits := []int{0, 1, 2, 3}
+	if count > 0 && s.hands[player][s.trick[0].suit] != 0 {
+		suits = suits[s.trick[0].suit : s.trick[0].suit+1]
+	}
+	var best ddPlay
+	partnerSure := false
+	if count > 0 {
+		best = s.trick[s.winning(count)]
+		partnerSure = best.player%2 == player%2 && s.sure(best, s.trick[0].suit, player, count)
+	}
+	for _, suit := range suits {
+		held := s.hands[player][suit]
+		if held == 0 {
+			continue
+		}
+		remaining := s.remaining(suit)
+		for i := 0; i < count; i++ {
+			if s.trick[i].suit == suit {
+				remaining |= 1 << uint(s.trick[i].rank)
+			}
+		}
+		for left := held; left != 0; left &= left - 1 {
+			rank := bits.TrailingZeros16(left)
+			//Only the lowest card of a sequence is tried.
+			lower := remaining & (1<<uint(rank) - 1)
+			if lower != 0 && held&(1<<uint(highestBit(lower))) != 0 {
+				moves[len(moves)-1].sequence |= 1 << uint(rank)
+				continue
+			}
+			m := ddMove{suit: suit, rank: rank, sequence: 1 << uint(rank)}
+			m.order = s.order(player, count, m, remaining, best, partnerSure)
+			moves = append(moves, m)
+		}
+	}
+	for i := 1; i < len(moves); i++ {
+		for j := i; j > 0 && moves[j].order > moves[j-1].order; j-- {
+			moves[j], moves[j-1] = moves[j-1], moves[j]
+		}
+	}
+	return moves
+}
+
+//order returns how early a move is tried, cards that make sure of the trick cheaply first,
+//then low cards, then cards that only win the trick until they are beaten.
+//
+//The best play of the trick so far and whether it is the partner's and can not be beaten are given after the lead.
+func (s *ddSolver) order(player, count int, m ddMove, remaining uint16, best ddPlay, partnerSure bool) int {
+	play := ddPlay{player: player, suit: m.suit, rank: m.rank}
+	if count == 0 {
+		partner, left, right := s.hands[(player+2)%4], s.hands[(player+1)%4], s.hands[(player+3)%4]
+		//Suits the opponents have fewer cards of leave them fewer plays to try.
+		others := 2 * (bitCount(left[m.suit]) + bitCount(right[m.suit]))
+		switch {
+		case s.sure(play, m.suit, player, 0):
+			return 45*64 - others - m.rank
+		case s.ruffs(left, m.suit):
+			return 10*64 - others - m.rank
+		case partner[m.suit] != 0 && highestBit(partner[m.suit]) == highestBit(remaining) && !s.ruffs(right, m.suit):
+			return 80*64 - others - m.rank
+		case s.ruffs(partner, m.suit) && !s.ruffs(right, m.suit):
+			return 75*64 - others - m.rank
+		}
+		return 40*64 - others - m.rank
+	}
+	led := s.trick[0].suit
+	switch {
+	case partnerSure:
+		//Discard from long suits.
+		return 100 - m.rank + bitCount(s.hands[player][m.suit])
+	case s.beats(play, best) && s.sure(play, led, player, count):
+		return 90 - m.rank
+	case s.beats(play, best):
+		return 30 - m.rank
+	}
+	return 50 - m.rank + bitCount(s.hands[player][m.suit])
+}
+
+//sure returns true if none of the players after the player at the count card of the trick
+//can beat the winning play for the other side.
+func (s *ddSolver) sure(winning ddPlay, led, player, count int) bool {
+	for i := count + 1; i < 4; i++ {
+		player = (player + 1) % 4
+		if player%2 != winning.player%2 && s.canBeat(s.hands[player], led, winning) {
+			return false
+		}
+	}
+	return true
+}
+
+//canBeat returns true if a hand can beat the winning play of a trick led in the led suit.
+func (s *ddSolver) canBeat(hand [4]uint16, led int, winning ddPlay) bool {
+	if hand[led] != 0 {
+		return winning.suit == led && highestBit(hand[led]) > winning.rank
+	}
+	if s.trump < 0 || hand[s.trump] == 0 {
+		return false
+	}
+	return winning.suit != s.trump || highestBit(hand[s.trump]) > winning.rank
+}
+
+//ruffs returns true if a hand can trump a suit.
+func (s *ddSolver) ruffs(hand [4]uint16, suit int) bool {
+	return s.trump >= 0 && suit != s.trump && hand[suit] == 0 && hand[s.trump] != 0
+}
+
+//bitCount returns the number of bits set.
+func bitCount(set uint16) int {
+	return bits.OnesCount16(set)
+}
+
+//highestBit returns the index of the highest bit set, -1 if none are.
+func highestBit(set uint16) int {
+	return bits.Len16(set) - 1
+}
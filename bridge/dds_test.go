@@ -0,0 +1,115 @@
+package bridge
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/anthonyrouseau/games/cards"
+	"github.com/stretchr/testify/assert"
+)
+
+//ending returns the hands of North, East, South and West written as in a PBN deal.
+func ending(notations ...string) []*cards.Hand {
+	hands := make([]*cards.Hand, len(notations))
+	for i, notation := range notations {
+		hands[i], _ = parseHand(strings.Split(notation, "."))
+	}
+	return hands
+}
+
+func TestDoubleDummy(t *testing.T) {
+	assert := assert.New(t)
+	b, _ := ParsePBN("[Board \"1\"]\n[Deal \"" + suitDeal + "\"]")
+	assert.Equal(TrickTable{
+		Clubs:    {0, 13, 0, 13},
+		Diamonds: {13, 0, 13, 0},
+		Hearts:   {0, 13, 0, 13},
+		Spades:   {13, 0, 13, 0},
+		NoTrump:  {0, 0, 0, 0},
+	}, b.DoubleDummy())
+}
+
+//playedDeal is a deal from play whose tricks were checked by an exhaustive search of every card,
+//remembering only whole positions.
+const playedDeal = "N:J53.K62.A964.T83 A762.QT954.KJ5.9 KQ9.J73.QT83.AQ6 T84.A8.72.KJ7542"
+
+func TestDoubleDummyPlayedDeal(t *testing.T) {
+	assert := assert.New(t)
+	b, _ := ParsePBN("[Board \"1\"]\n[Deal \"" + playedDeal + "\"]")
+	assert.Equal(TrickTable{
+		Clubs:    {5, 7, 5, 7},
+		Diamonds: {7, 6, 7, 6},
+		Hearts:   {6, 7, 6, 7},
+		Spades:   {5, 7, 5, 7},
+		NoTrump:  {7, 6, 7, 6},
+	}, b.DoubleDummy())
+}
+
+func TestDoubleDummyTricksTime(t *testing.T) {
+	if testing.Short() {
+		t.Skip("times a full deal")
+	}
+	assert := assert.New(t)
+	b, _ := ParsePBN("[Board \"1\"]\n[Deal \"" + playedDeal + "\"]")
+	for strain := Clubs; strain <= NoTrump; strain++ {
+		start := time.Now()
+		_, err := DoubleDummyTricks(b.hands[:], strain, South)
+		assert.NoError(err)
+		assert.Less(int64(time.Since(start)), int64(time.Second), "%v", strain)
+	}
+}
+
+func BenchmarkDoubleDummy(b *testing.B) {
+	board, _ := ParsePBN("[Board \"1\"]\n[Deal \"" + playedDeal + "\"]")
+	for i := 0; i < b.N; i++ {
+		board.DoubleDummy()
+	}
+}
+
+func TestDoubleDummyTricks(t *testing.T) {
+	assert := assert.New(t)
+	//The Spade finesse works when South leads towards the Ace and Queen but not when North leads them.
+	finesse := ending("AQ...", "...32", "65...", "K4...")
+	tests := []struct {
+		hands    []*cards.Hand
+		strain   Strain
+		declarer Seat
+		tricks   int
+	}{
+		{finesse, NoTrump, North, 0},
+		{finesse, NoTrump, East, 0},
+		{finesse, NoTrump, South, 2},
+		{finesse, NoTrump, West, 1},
+		{finesse, Clubs, North, 0},
+		{ending("A...", "K...", "Q...", "J..."), NoTrump, West, 0},
+		{ending("A...", "K...", "Q...", "J..."), NoTrump, East, 0},
+		{ending("...2", ".2..", "..2.", "2..."), Hearts, South, 0},
+		{ending("...2", ".2..", "..2.", "2..."), Diamonds, South, 1},
+	}
+	for _, test := range tests {
+		tricks, err := DoubleDummyTricks(test.hands, test.strain, test.declarer)
+		assert.NoError(err)
+		assert.Equal(test.tricks, tricks, "%v %v", test.strain, test.declarer)
+	}
+}
+
+func TestDoubleDummyTricksErrors(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		hands    []*cards.Hand
+		strain   Strain
+		declarer Seat
+		err      error
+	}{
+		{ending("A...", "K...", "Q..."), NoTrump, North, &InvalidHands{}},
+		{ending("A...", "K...", "Q...", "J2..."), NoTrump, North, &InvalidHands{}},
+		{ending("A...", "K...", "Q...", "A..."), NoTrump, North, &InvalidHands{}},
+		{ending("A...", "K...", "Q...", "J..."), Strain(5), North, &InvalidContract{}},
+		{ending("A...", "K...", "Q...", "J..."), NoTrump, Seat(4), &InvalidContract{}},
+	}
+	for _, test := range tests {
+		_, err := DoubleDummyTricks(test.hands, test.strain, test.declarer)
+		assert.IsType(test.err, err)
+	}
+}
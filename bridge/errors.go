@@ -0,0 +1,66 @@
+package bridge
+
+import (
+	"fmt"
+)
+
+//InvalidCall signals a call that is not allowed at this point of the auction.
+//
+//e.g. bidding 1H after 2C or doubling partner's bid.
+type InvalidCall struct {
+	call Call
+}
+
+func (e *InvalidCall) Error() string {
+	return fmt.Sprintf("Call %v is not allowed now.", e.call)
+}
+
+//InvalidContract signals a contract that can not be scored or played.
+//
+//e.g. a contract of 8 tricks or one that was passed out.
+type InvalidContract struct {
+	contract Contract
+}
+
+func (e *InvalidContract) Error() string {
+	return fmt.Sprintf("Contract %v is not valid.", e.contract)
+}
+
+//InvalidTricks signals a number of tricks outside 0 to 13.
+type InvalidTricks struct {
+	tricks int
+}
+
+func (e *InvalidTricks) Error() string {
+	return fmt.Sprintf("%d tricks is not possible.", e.tricks)
+}
+
+//InvalidBoard signals a board number below 1.
+type InvalidBoard struct {
+	number int
+}
+
+func (e *InvalidBoard) Error() string {
+	return fmt.Sprintf("Board %d is not valid.", e.number)
+}
+
+//InvalidHands signals hands that are not a deal of a standard deck to four players.
+//
+//e.g. hands of 12 cards or a card held twice.
+type InvalidHands struct{}
+
+func (e *InvalidHands) Error() string {
+	return "The hands are not a deal of 13 cards to each player."
+}
+
+//InvalidNotation signals text that can not be read in a format.
+//
+//e.g. a PBN deal without four hands.
+type InvalidNotation struct {
+	format string
+	text   string
+}
+
+func (e *InvalidNotation) Error() string {
+	return fmt.Sprintf("Text %q is not valid %s.", e.text, e.format)
+}
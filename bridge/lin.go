@@ -0,0 +1,127 @@
+package bridge
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/anthonyrouseau/games/cards"
+)
+
+//linSeats are the seats in the order LIN writes their hands, the dealer is written as 1 to 4 in the same order.
+var linSeats = []Seat{South, West, North, East}
+
+var linVulnerabilities = map[Vulnerability]string{
+	None:       "o",
+	NorthSouth: "n",
+	EastWest:   "e",
+	Both:       "b",
+}
+
+//LIN returns the board written as the md, sv and ah fields of BBO's LIN format
+//e.g. "md|3SAKQHJT9D876C5432,...|sv|o|ah|Board 1|".
+//
+//The deal starts with the dealer's number followed by the hands of South, West, North and East,
+//each hand written as the letter of each suit followed by its ranks.
+func (b *Board) LIN() string {
+	hands := make([]string, len(linSeats))
+	dealer := 0
+	for i, seat := range linSeats {
+		hands[i] = formatHand(b.hands[seat], "", true)
+		if seat == b.dealer {
+			dealer = i + 1
+		}
+	}
+	return fmt.Sprintf("md|%d%s|sv|%s|ah|Board %d|", dealer, strings.Join(hands, ","), linVulnerabilities[b.vulnerability], b.number)
+}
+
+//ParseLIN returns the board read from the fields of a deal in BBO's LIN format.
+//
+//The md field is required and may leave out the last hand which is given the cards remaining.
+//The board number is read from an ah field of the form "Board 12" or a qx field of the form "o12",
+//the sv field defaults to the vulnerability of the board number and the dealer follows md.
+//Errors with InvalidNotation if a field can not be read or the deal is not valid.
+func ParseLIN(text string) (*Board, error) {
+	fields := strings.Split(strings.TrimSpace(text), "|")
+	values := map[string]string{}
+	for i := 0; i+1 < len(fields); i += 2 {
+		key := strings.ToLower(strings.TrimSpace(fields[i]))
+		if _, ok := values[key]; !ok {
+			values[key] = fields[i+1]
+		}
+	}
+	number := 0
+	if ah, ok := values["ah"]; ok {
+		number, _ = strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(ah, "Board")))
+	} else if qx, ok := values["qx"]; ok && len(qx) > 1 {
+		number, _ = strconv.Atoi(qx[1:])
+	}
+	if number < 1 {
+		return nil, &InvalidNotation{format: "LIN", text: text}
+	}
+	deal := values["md"]
+	if len(deal) < 1 || deal[0] < '1' || deal[0] > '4' {
+		return nil, &InvalidNotation{format: "LIN", text: deal}
+	}
+	written := strings.Split(deal[1:], ",")
+	if len(written) < 3 || len(written) > 4 {
+		return nil, &InvalidNotation{format: "LIN", text: deal}
+	}
+	hands := make([]*cards.Hand, 4)
+	var dealt cards.CardSet
+	for i, field := range written {
+		hand, ok := parseLINHand(field)
+		if !ok {
+			return nil, &InvalidNotation{format: "LIN", text: field}
+		}
+		if i == 3 && hand.CardCount() == 0 {
+			break
+		}
+		hands[linSeats[i]] = hand
+		dealt |= cards.NewCardSet(hand.Cards()...)
+	}
+	if last := linSeats[3]; hands[last] == nil {
+		deck := cards.NewStandardDeck(false)
+		remaining := cards.NewCardSet(deck.Cards()...).Difference(dealt).Cards()
+		pile, err := cards.NewPile(13, remaining...)
+		if err != nil {
+			return nil, &InvalidNotation{format: "LIN", text: deal}
+		}
+		hands[last] = &cards.Hand{Pile: pile}
+	}
+	b, err := NewBoard(number, hands)
+	if err != nil {
+		return nil, &InvalidNotation{format: "LIN", text: deal}
+	}
+	b.dealer = linSeats[deal[0]-'1']
+	if sv, ok := values["sv"]; ok {
+		b.vulnerability = -1
+		for vulnerability, name := range linVulnerabilities {
+			if strings.ToLower(sv) == name || (vulnerability == None && (sv == "0" || sv == "-")) {
+				b.vulnerability = vulnerability
+			}
+		}
+		if b.vulnerability < 0 {
+			return nil, &InvalidNotation{format: "LIN", text: sv}
+		}
+	}
+	return b, nil
+}
+
+//parseLINHand returns the hand written as the letter of each suit followed by its ranks.
+func parseLINHand(s string) (*cards.Hand, bool) {
+	ranks := make([]string, len(handSuits))
+	suit := -1
+	for _, r := range strings.ToUpper(s) {
+		switch r {
+		case 'S', 'H', 'D', 'C':
+			suit = strings.IndexRune("SHDC", r)
+		default:
+			if suit < 0 {
+				return nil, false
+			}
+			ranks[suit] += string(r)
+		}
+	}
+	return parseHand(ranks)
+}
@@ -0,0 +1,151 @@
+package bridge
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/anthonyrouseau/games/cards"
+)
+
+//handSuits are the suits of a hand in the order PBN and LIN write them.
+var handSuits = []cards.SuitName{cards.Spades, cards.Hearts, cards.Diamonds, cards.Clubs}
+
+var pbnTag = regexp.MustCompile(`\[(\w+)\s+"([^"]*)"\]`)
+
+//PBN returns the board written as the Board, Dealer, Vulnerable and Deal tags of Portable Bridge Notation.
+//
+//The deal starts with the dealer's hand, each hand is written as its Spades, Hearts, Diamonds and Clubs
+//separated by dots e.g. [Deal "N:AKQ.JT9.876.5432 ..."].
+func (b *Board) PBN() string {
+	hands := make([]string, 4)
+	for i := range hands {
+		hands[i] = formatHand(b.hands[(int(b.dealer)+i)%4], ".", false)
+	}
+	return fmt.Sprintf("[Board \"%d\"]\n[Dealer \"%s\"]\n[Vulnerable \"%v\"]\n[Deal \"%s:%s\"]\n",
+		b.number, b.dealer.short(), b.vulnerability, b.dealer.short(), strings.Join(hands, " "))
+}
+
+//ParsePBN returns the board read from the tags of a game in Portable Bridge Notation.
+//
+//The Board and Deal tags are required, the Dealer and Vulnerable tags default to those of the board number.
+//Errors with InvalidNotation if a tag can not be read or the deal is not valid.
+func ParsePBN(text string) (*Board, error) {
+	tags := map[string]string{}
+	for _, match := range pbnTag.FindAllStringSubmatch(text, -1) {
+		if _, ok := tags[match[1]]; !ok {
+			tags[match[1]] = match[2]
+		}
+	}
+	number, err := strconv.Atoi(tags["Board"])
+	if err != nil || number < 1 {
+		return nil, &InvalidNotation{format: "PBN", text: tags["Board"]}
+	}
+	deal := tags["Deal"]
+	if len(deal) < 2 || deal[1] != ':' {
+		return nil, &InvalidNotation{format: "PBN", text: deal}
+	}
+	first, ok := parseSeat(deal[:1])
+	fields := strings.Fields(deal[2:])
+	if !ok || len(fields) != 4 {
+		return nil, &InvalidNotation{format: "PBN", text: deal}
+	}
+	hands := make([]*cards.Hand, 4)
+	for i, field := range fields {
+		suits := strings.Split(field, ".")
+		if len(suits) != 4 {
+			return nil, &InvalidNotation{format: "PBN", text: field}
+		}
+		hand, ok := parseHand(suits)
+		if !ok {
+			return nil, &InvalidNotation{format: "PBN", text: field}
+		}
+		hands[(int(first)+i)%4] = hand
+	}
+	b, err := NewBoard(number, hands)
+	if err != nil {
+		return nil, &InvalidNotation{format: "PBN", text: deal}
+	}
+	if dealer, ok := tags["Dealer"]; ok {
+		if b.dealer, ok = parseSeat(dealer); !ok {
+			return nil, &InvalidNotation{format: "PBN", text: dealer}
+		}
+	}
+	if vulnerable, ok := tags["Vulnerable"]; ok {
+		switch strings.ToUpper(vulnerable) {
+		case "NONE", "LOVE", "-":
+			b.vulnerability = None
+		case "NS":
+			b.vulnerability = NorthSouth
+		case "EW":
+			b.vulnerability = EastWest
+		case "ALL", "BOTH":
+			b.vulnerability = Both
+		default:
+			return nil, &InvalidNotation{format: "PBN", text: vulnerable}
+		}
+	}
+	return b, nil
+}
+
+//parseSeat returns the seat written as its first letter.
+func parseSeat(s string) (Seat, bool) {
+	for seat := North; seat <= West; seat++ {
+		if strings.ToUpper(s) == seat.short() {
+			return seat, true
+		}
+	}
+	return North, false
+}
+
+//formatHand writes the ranks of each suit of the hand from the Ace down separated by sep,
+//with each suit after its letter when prefixed.
+func formatHand(hand *cards.Hand, sep string, prefixed bool) string {
+	held := hand.Cards()
+	sort.Slice(held, func(i, j int) bool {
+		return rankOrder(held[i].Rank()) > rankOrder(held[j].Rank())
+	})
+	parts := make([]string, len(handSuits))
+	for i, suit := range handSuits {
+		var b strings.Builder
+		if prefixed {
+			b.WriteString(strings.ToUpper(suit.String()))
+		}
+		for _, card := range held {
+			if card.Suit().Name() == suit {
+				b.WriteString(card.Rank().String())
+			}
+		}
+		parts[i] = b.String()
+	}
+	return strings.Join(parts, sep)
+}
+
+//parseHand returns the hand holding the ranks written for each suit in the order of handSuits.
+func parseHand(ranks []string) (*cards.Hand, bool) {
+	held := []cards.Card{}
+	for i, suit := range handSuits[:len(ranks)] {
+		for _, rank := range ranks[i] {
+			card, err := cards.ParseCard(string(rank) + suit.String())
+			if err != nil {
+				return nil, false
+			}
+			held = append(held, card)
+		}
+	}
+	pile, err := cards.NewPile(13, held...)
+	if err != nil {
+		return nil, false
+	}
+	return &cards.Hand{Pile: pile}, true
+}
+
+//rankOrder returns the order of a rank with Aces high.
+func rankOrder(rank cards.Rank) int {
+	if rank == cards.Ace {
+		return int(cards.King) + 1
+	}
+	return int(rank)
+}
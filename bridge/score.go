@@ -0,0 +1,100 @@
+package bridge
+
+//Score returns the duplicate score of the declarer's partnership for the contract taking the tricks,
+//negative when the contract is defeated.
+//
+//A made contract scores its trick points, 20 a trick in the minors, 30 in the majors and
+//40 for the first trick then 30 in NoTrump, doubled or redoubled by the penalty.
+//Contracts with trick points of 100 or more win the game bonus of 300, 500 vulnerable,
+//otherwise the part score bonus of 50. Small slams win 500, 750 vulnerable,
+//grand slams 1000, 1500 vulnerable, and doubled or redoubled contracts win 50 or 100 for the insult.
+//Overtricks score as trick points when undoubled or 100 each doubled, 200 vulnerable, twice that redoubled.
+//Undertricks lose 50 each, 100 vulnerable, when undoubled. Doubled they lose 100, then 200 each for
+//the second and third and 300 each after, or 200 then 300 each vulnerable, twice that redoubled.
+//A passed out contract scores 0.
+//Errors if the contract is not valid or tricks is not 0 to 13.
+func Score(c Contract, vulnerable bool, tricks int) (int, error) {
+	if tricks < 0 || tricks > 13 {
+		return 0, &InvalidTricks{tricks: tricks}
+	}
+	if c.PassedOut() {
+		return 0, nil
+	}
+	if !c.valid() {
+		return 0, &InvalidContract{contract: c}
+	}
+	multiplier := 1 << uint(c.Penalty)
+	needed := c.Level + 6
+	if tricks < needed {
+		return -undertricks(needed-tricks, c.Penalty, vulnerable), nil
+	}
+	score := trickPoints(c.Strain, c.Level) * multiplier
+	switch {
+	case score >= 100 && vulnerable:
+		score += 500
+	case score >= 100:
+		score += 300
+	default:
+		score += 50
+	}
+	switch {
+	case c.Level == 6 && vulnerable:
+		score += 750
+	case c.Level == 6:
+		score += 500
+	case c.Level == 7 && vulnerable:
+		score += 1500
+	case c.Level == 7:
+		score += 1000
+	}
+	overtricks := tricks - needed
+	if c.Penalty == Undoubled {
+		score += trickPoints(c.Strain, c.Level+overtricks) - trickPoints(c.Strain, c.Level)
+	} else {
+		score += 50 * int(c.Penalty)
+		each := 100
+		if vulnerable {
+			each = 200
+		}
+		score += overtricks * each * multiplier / 2
+	}
+	return score, nil
+}
+
+//undertricks returns the points lost for going down.
+func undertricks(down int, penalty Penalty, vulnerable bool) int {
+	if penalty == Undoubled {
+		if vulnerable {
+			return 100 * down
+		}
+		return 50 * down
+	}
+	lost := 0
+	for i := 1; i <= down; i++ {
+		switch {
+		case i == 1 && vulnerable:
+			lost += 200
+		case i == 1:
+			lost += 100
+		case vulnerable || i >= 4:
+			lost += 300
+		default:
+			lost += 200
+		}
+	}
+	if penalty == Redoubled {
+		lost *= 2
+	}
+	return lost
+}
+
+//trickPoints returns the undoubled trick points of tricks bid in the strain.
+func trickPoints(strain Strain, tricks int) int {
+	switch strain {
+	case Clubs, Diamonds:
+		return 20 * tricks
+	case NoTrump:
+		return 30*tricks + 10
+	}
+	return 30 * tricks
+}
@@ -0,0 +1,49 @@
+package bridge
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScore(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		contract   string
+		penalty    Penalty
+		vulnerable bool
+		tricks     int
+		expected   int
+	}{
+		{"4S", Undoubled, true, 10, 620},
+		{"3NT", Undoubled, false, 9, 400},
+		{"3NT", Undoubled, true, 10, 630},
+		{"2C", Undoubled, false, 10, 130},
+		{"1NT", Doubled, false, 7, 180},
+		{"2H", Doubled, false, 9, 570},
+		{"1NT", Redoubled, false, 7, 560},
+		{"1NT", Redoubled, true, 8, 1160},
+		{"6C", Undoubled, false, 12, 920},
+		{"7NT", Undoubled, true, 13, 2220},
+		{"4S", Undoubled, false, 9, -50},
+		{"3NT", Undoubled, true, 7, -200},
+		{"4H", Doubled, false, 7, -500},
+		{"4H", Doubled, false, 6, -800},
+		{"4H", Doubled, true, 7, -800},
+		{"4H", Redoubled, true, 8, -1000},
+	}
+	for _, test := range tests {
+		bid, _ := ParseCall(test.contract)
+		contract := Contract{Level: bid.Level, Strain: bid.Strain, Penalty: test.penalty, Declarer: South}
+		score, err := Score(contract, test.vulnerable, test.tricks)
+		assert.NoError(err)
+		assert.Equal(test.expected, score, contract.String())
+	}
+	score, err := Score(Contract{}, true, 0)
+	assert.NoError(err)
+	assert.Equal(0, score)
+	_, err = Score(Contract{Level: 8, Strain: Clubs}, true, 13)
+	assert.IsType(&InvalidContract{}, err)
+	_, err = Score(Contract{Level: 1, Strain: Clubs}, true, 14)
+	assert.IsType(&InvalidTricks{}, err)
+}
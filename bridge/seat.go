@@ -0,0 +1,113 @@
+package bridge
+
+//Seat is a player's position at the table.
+type Seat int
+
+//Seat values in the order they play.
+const (
+	North Seat = iota
+	East
+	South
+	West
+)
+
+var seatNames = map[Seat]string{
+	North: "North",
+	East:  "East",
+	South: "South",
+	West:  "West",
+}
+
+//String returns the name of the seat.
+func (s Seat) String() string {
+	if name, ok := seatNames[s]; ok {
+		return name
+	}
+	return "Unknown"
+}
+
+//short returns the first letter of the seat's name.
+func (s Seat) short() string {
+	return s.String()[:1]
+}
+
+//Next returns the seat to the left, who calls and plays after the seat.
+func (s Seat) Next() Seat {
+	return (s + 1) % 4
+}
+
+//Partner returns the seat opposite.
+func (s Seat) Partner() Seat {
+	return (s + 2) % 4
+}
+
+//valid returns true if the seat is one of the four seats.
+func (s Seat) valid() bool {
+	return s >= North && s <= West
+}
+
+//Vulnerability is which partnerships are vulnerable on a board.
+type Vulnerability int
+
+//Vulnerability values
+const (
+	None Vulnerability = iota
+	NorthSouth
+	EastWest
+	Both
+)
+
+var vulnerabilityNames = map[Vulnerability]string{
+	None:       "None",
+	NorthSouth: "NS",
+	EastWest:   "EW",
+	Both:       "All",
+}
+
+//String returns the name of the vulnerability as written in PBN e.g. "NS".
+func (v Vulnerability) String() string {
+	if name, ok := vulnerabilityNames[v]; ok {
+		return name
+	}
+	return "Unknown"
+}
+
+//Vulnerable returns true if the seat's partnership is vulnerable.
+func (v Vulnerability) Vulnerable(s Seat) bool {
+	switch v {
+	case Both:
+		return true
+	case NorthSouth:
+		return s == North || s == South
+	case EastWest:
+		return s == East || s == West
+	}
+	return false
+}
+
+//boardVulnerabilities are the vulnerabilities of boards 1 to 16, repeated for later boards.
+var boardVulnerabilities = []Vulnerability{
+	None, NorthSouth, EastWest, Both,
+	NorthSouth, EastWest, Both, None,
+	EastWest, Both, None, NorthSouth,
+	Both, None, NorthSouth, EastWest,
+}
+
+//BoardDealer returns the dealer of a board number, North deals board 1 and the deal passes to the left.
+//
+//Numbers below 1 continue the cycle backwards so board 0 is dealt like board 4.
+func BoardDealer(number int) Seat {
+	return Seat(cycle(number, 4))
+}
+
+//BoardVulnerability returns the vulnerability of a board number in the standard 16 board cycle.
+//
+//Numbers below 1 continue the cycle backwards so board 0 has the vulnerability of board 16.
+func BoardVulnerability(number int) Vulnerability {
+	return boardVulnerabilities[cycle(number, len(boardVulnerabilities))]
+}
+
+//cycle returns the position of a board number in a cycle of n boards starting from board 1.
+func cycle(number, n int) int {
+	return ((number-1)%n + n) % n
+}
@@ -0,0 +1,141 @@
+package cards
+
+//DeckOption configures the cards of a Deck built by NewDeck.
+type DeckOption func(*deckConfig)
+
+type deckConfig struct {
+	ranks  []Rank
+	suits  []SuitName
+	copies int
+	jokers int
+}
+
+//WithRanks sets the ranks of the cards in each suit.
+//
+//Jokers are added with WithJokers not as ranks.
+func WithRanks(ranks ...Rank) DeckOption {
+	return func(c *deckConfig) {
+		c.ranks = ranks
+	}
+}
+
+//WithSuits sets the suits of the deck.
+func WithSuits(suits ...SuitName) DeckOption {
+	return func(c *deckConfig) {
+		c.suits = suits
+	}
+}
+
+//WithCopies sets how many copies of each card the deck holds.
+func WithCopies(copies int) DeckOption {
+	return func(c *deckConfig) {
+		c.copies = copies
+	}
+}
+
+//WithJokers sets the number of jokers, alternating between the LittleJoker and BigJoker.
+func WithJokers(jokers int) DeckOption {
+	return func(c *deckConfig) {
+		c.jokers = jokers
+	}
+}
+
+//NewDeck returns a Deck built from the options with a maxSize of the number of cards.
+//
+//Without options the deck has the 52 cards of a standard deck.
+//Cards are ordered by copy, then suit in the given order, then rank in the given order,
+//followed by the jokers.
+//
+//Errors with InvalidCard if a rank or suit can not make a standard card
+//and InvalidOption if the copies are less than 1 or the jokers are negative.
+func NewDeck(opts ...DeckOption) (Deck, error) {
+	config := deckConfig{
+		ranks:  allRanks()[:13],
+		suits:  []SuitName{Clubs, Spades, Diamonds, Hearts},
+		copies: 1,
+	}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	if config.copies < 1 {
+		return Deck{}, &InvalidOption{option: "copies"}
+	}
+	if config.jokers < 0 {
+		return Deck{}, &InvalidOption{option: "jokers"}
+	}
+	suits := make([]Suit, len(config.suits))
+	for i, name := range config.suits {
+		suit, ok := suitFor(Ace, name)
+		if !ok {
+			return Deck{}, &InvalidCard{rank: Ace, suit: name}
+		}
+		suits[i] = suit
+	}
+	for _, rank := range config.ranks {
+		if _, ok := suitFor(rank, Clubs); !ok {
+			return Deck{}, &InvalidCard{rank: rank, suit: Clubs}
+		}
+	}
+	cards := make([]Card, 0, config.copies*len(suits)*len(config.ranks)+config.jokers)
+	for i := 0; i < config.copies; i++ {
+		for _, suit := range suits {
+			for _, rank := range config.ranks {
+				cards = append(cards, Card{suit: suit, rank: rank})
+			}
+		}
+	}
+	for i := 0; i < config.jokers; i++ {
+		if i%2 == 0 {
+			cards = append(cards, Card{suit: BlackJokerSuit, rank: LittleJoker})
+		} else {
+			cards = append(cards, Card{suit: RedJokerSuit, rank: BigJoker})
+		}
+	}
+	return Deck{
+		Pile: Pile{
+			maxSize: len(cards),
+			cards:   cards,
+		},
+	}, nil
+}
+
+//mustNewDeck returns the deck built from options known to be valid.
+func mustNewDeck(opts ...DeckOption) Deck {
+	deck, err := NewDeck(opts...)
+	if err != nil {
+		panic(err)
+	}
+	return deck
+}
+
+//NewEuchreDeck returns the 24 card Euchre deck of Nines through Aces.
+func NewEuchreDeck() Deck {
+	return mustNewDeck(WithRanks(Nine, Ten, Jack, Queen, King, Ace))
+}
+
+//NewPiquetDeck returns the 32 card Piquet deck of Sevens through Aces.
+func NewPiquetDeck() Deck {
+	return mustNewDeck(WithRanks(Seven, Eight, Nine, Ten, Jack, Queen, King, Ace))
+}
+
+//NewPinochleDeck returns the 48 card Pinochle deck of two copies of Nines through Aces.
+func NewPinochleDeck() Deck {
+	return mustNewDeck(WithRanks(Nine, Ten, Jack, Queen, King, Ace), WithCopies(2))
+}
+
+//NewSpanishDeck returns the 40 card Spanish deck of Aces through Sevens and the face cards.
+//
+//The Spanish suits of coins, cups, swords and clubs map onto
+//Diamonds, Hearts, Spades and Clubs and the Jack, Queen and King
+//stand in for the sota, caballo and rey.
+func NewSpanishDeck() Deck {
+	return mustNewDeck(
+		WithRanks(Ace, Two, Three, Four, Five, Six, Seven, Jack, Queen, King),
+		WithSuits(Diamonds, Hearts, Spades, Clubs),
+	)
+}
+
+//NewCanastaDeck returns the 108 card Canasta deck of two standard decks and four jokers.
+func NewCanastaDeck() Deck {
+	return mustNewDeck(WithCopies(2), WithJokers(4))
+}
@@ -0,0 +1,77 @@
+package cards
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDeck(t *testing.T) {
+	assert := assert.New(t)
+	t.Run("default", func(t *testing.T) {
+		deck, err := NewDeck()
+		if assert.NoError(err) {
+			assert.Equal(NewStandardDeck(false).cards, deck.cards)
+			assert.Equal(52, deck.maxSize)
+		}
+	})
+	t.Run("options", func(t *testing.T) {
+		deck, err := NewDeck(WithRanks(King, Ace), WithSuits(Hearts), WithCopies(3), WithJokers(3))
+		if assert.NoError(err) {
+			cards, _ := ParseCards("Kh Ah Kh Ah Kh Ah jk JK jk")
+			assert.Equal(cards, deck.cards)
+			assert.Equal(9, deck.MaxSize())
+		}
+	})
+	t.Run("invalid", func(t *testing.T) {
+		_, err := NewDeck(WithRanks(LittleJoker))
+		assert.IsType(&InvalidCard{}, err)
+		_, err = NewDeck(WithSuits(Joker))
+		assert.IsType(&InvalidCard{}, err)
+		_, err = NewDeck(WithCopies(0))
+		assert.IsType(&InvalidOption{}, err)
+		_, err = NewDeck(WithJokers(-1))
+		assert.IsType(&InvalidOption{}, err)
+	})
+}
+
+func TestPresetDecks(t *testing.T) {
+	assert := assert.New(t)
+	countRanks := func(deck Deck) map[Rank]int {
+		counts := map[Rank]int{}
+		for _, card := range deck.cards {
+			counts[card.rank]++
+		}
+		return counts
+	}
+	t.Run("euchre", func(t *testing.T) {
+		deck := NewEuchreDeck()
+		assert.Equal(24, deck.MaxSize())
+		assert.Equal(map[Rank]int{Nine: 4, Ten: 4, Jack: 4, Queen: 4, King: 4, Ace: 4}, countRanks(deck))
+	})
+	t.Run("piquet", func(t *testing.T) {
+		deck := NewPiquetDeck()
+		assert.Equal(32, deck.MaxSize())
+		assert.Len(countRanks(deck), 8)
+	})
+	t.Run("pinochle", func(t *testing.T) {
+		deck := NewPinochleDeck()
+		assert.Equal(48, deck.MaxSize())
+		assert.Equal(map[Rank]int{Nine: 8, Ten: 8, Jack: 8, Queen: 8, King: 8, Ace: 8}, countRanks(deck))
+		assert.Equal(24, deck.CardSet().Count())
+	})
+	t.Run("spanish", func(t *testing.T) {
+		deck := NewSpanishDeck()
+		assert.Equal(40, deck.MaxSize())
+		counts := countRanks(deck)
+		assert.Zero(counts[Eight] + counts[Nine] + counts[Ten])
+	})
+	t.Run("canasta", func(t *testing.T) {
+		deck := NewCanastaDeck()
+		assert.Equal(108, deck.MaxSize())
+		counts := countRanks(deck)
+		assert.Equal(2, counts[LittleJoker])
+		assert.Equal(2, counts[BigJoker])
+		assert.Equal(8, counts[Ace])
+	})
+}
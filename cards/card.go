@@ -6,6 +6,34 @@ type Card struct {
 	rank Rank
 }
 
+//NewCard returns a Card with the given rank and suit.
+//
+//The suit color is set from the suit name. Jokers use the Joker suit name,
+//the LittleJoker is black and the BigJoker is red.
+//Errors if the rank and suit are not a valid combination.
+func NewCard(rank Rank, suit SuitName) (Card, error) {
+	s, ok := suitFor(rank, suit)
+	if !ok {
+		return Card{}, &InvalidCard{rank: rank, suit: suit}
+	}
+	return Card{suit: s, rank: rank}, nil
+}
+
+//Rank returns the rank of the card.
+func (c Card) Rank() Rank {
+	return c.rank
+}
+
+//Suit returns the suit of the card.
+func (c Card) Suit() Suit {
+	return c.suit
+}
+
+//Color returns the color of the card's suit.
+func (c Card) Color() SuitColor {
+	return c.suit.color
+}
+
 //Matches returns true if the cards match rank and suit.
 func (c *Card) Matches(other *Card) bool {
 	return c.MatchesRank(other) && c.MatchesSuit(other)
@@ -38,6 +66,14 @@ func (r *Rank) isEmpty() bool {
 	return int(*r) == 0
 }
 
+func (r Rank) isJoker() bool {
+	return r == LittleJoker || r == BigJoker
+}
+
+func (r Rank) isValid() bool {
+	return r >= Ace && r <= BigJoker
+}
+
 //Rank Values
 const (
 	Ace Rank = iota + 1
@@ -67,12 +103,58 @@ type Suit struct {
 	name  SuitName
 }
 
+//Suit values
+var (
+	ClubsSuit      = Suit{color: Black, name: Clubs}
+	SpadesSuit     = Suit{color: Black, name: Spades}
+	DiamondsSuit   = Suit{color: Red, name: Diamonds}
+	HeartsSuit     = Suit{color: Red, name: Hearts}
+	BlackJokerSuit = Suit{color: Black, name: Joker}
+	RedJokerSuit   = Suit{color: Red, name: Joker}
+)
+
+//Name returns the name of the suit.
+func (s Suit) Name() SuitName {
+	return s.name
+}
+
+//Color returns the color of the suit.
+func (s Suit) Color() SuitColor {
+	return s.color
+}
+
 func (s *Suit) isEmpty() bool {
 	return string(s.color) == "" && string(s.name) == ""
 }
 
 func allSuits() []Suit {
-	return []Suit{{color: Black, name: Clubs}, {color: Black, name: Spades}, {color: Red, name: Diamonds}, {color: Red, name: Hearts}, {color: Black, name: Joker}, {color: Red, name: Joker}}
+	return []Suit{ClubsSuit, SpadesSuit, DiamondsSuit, HeartsSuit, BlackJokerSuit, RedJokerSuit}
+}
+
+//suitFor returns the suit a card of the given rank and suit name belongs to.
+func suitFor(rank Rank, name SuitName) (Suit, bool) {
+	if !rank.isValid() {
+		return Suit{}, false
+	}
+	switch {
+	case rank == LittleJoker && name == Joker:
+		return BlackJokerSuit, true
+	case rank == BigJoker && name == Joker:
+		return RedJokerSuit, true
+	case rank.isJoker() || name == Joker:
+		return Suit{}, false
+	}
+	switch name {
+	case Clubs:
+		return ClubsSuit, true
+	case Spades:
+		return SpadesSuit, true
+	case Diamonds:
+		return DiamondsSuit, true
+	case Hearts:
+		return HeartsSuit, true
+	}
+	return Suit{}, false
 }
 
 //SuitName is the suit name e.g. Clubs
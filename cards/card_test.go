@@ -62,3 +62,58 @@ func Test_Card_IsEmpty(t *testing.T) {
 		assert.False(deck.cards[0].IsEmpty())
 	})
 }
+
+func TestNewCard(t *testing.T) {
+	assert := assert.New(t)
+	t.Run("standard", func(t *testing.T) {
+		card, err := NewCard(Queen, Hearts)
+		if assert.NoError(err) {
+			assert.Equal(Queen, card.Rank())
+			assert.Equal(HeartsSuit, card.Suit())
+			assert.Equal(Red, card.Color())
+		}
+	})
+	t.Run("jokers", func(t *testing.T) {
+		little, err := NewCard(LittleJoker, Joker)
+		if assert.NoError(err) {
+			assert.Equal(BlackJokerSuit, little.Suit())
+		}
+		big, err := NewCard(BigJoker, Joker)
+		if assert.NoError(err) {
+			assert.Equal(RedJokerSuit, big.Suit())
+		}
+	})
+	t.Run("matches deck", func(t *testing.T) {
+		deck := NewStandardDeck(true)
+		for _, expected := range deck.cards {
+			card, err := NewCard(expected.rank, expected.suit.name)
+			if assert.NoError(err) {
+				assert.Equal(expected, card)
+			}
+		}
+	})
+	t.Run("invalid", func(t *testing.T) {
+		invalid := []struct {
+			rank Rank
+			suit SuitName
+		}{
+			{Ace, Joker},
+			{LittleJoker, Spades},
+			{Rank(0), Clubs},
+			{Rank(16), Clubs},
+			{King, SuitName("stars")},
+		}
+		for _, c := range invalid {
+			_, err := NewCard(c.rank, c.suit)
+			assert.IsType(&InvalidCard{}, err)
+		}
+	})
+}
+
+func Test_Suit_Accessors(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(Spades, SpadesSuit.Name())
+	assert.Equal(Black, SpadesSuit.Color())
+	assert.Equal(Joker, RedJokerSuit.Name())
+	assert.Equal(Red, RedJokerSuit.Color())
+}
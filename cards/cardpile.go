@@ -0,0 +1,13 @@
+package cards
+
+//CardPile is the interface for an ordered pile of cards such as a Deck or a Hand.
+//
+//Game logic written against CardPile works with either type.
+type CardPile interface {
+	Peeker
+	Picker
+	Placer
+	CardCount() int
+	MaxSize() int
+	HasCard(card *Card) bool
+}
@@ -0,0 +1,37 @@
+package cards
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//move takes the first card of from and places it first in to.
+func move(from, to CardPile) error {
+	cards, err := from.Pick([]int{0})
+	if err != nil {
+		return err
+	}
+	return to.Place(cards, []int{0})
+}
+
+func TestCardPile(t *testing.T) {
+	assert := assert.New(t)
+	deck := NewStandardDeck(false)
+	hands, err := deck.Deal(2, 5)
+	if assert.NoError(err) {
+		top, _ := deck.PeekTop()
+		hands[0].maxSize = 6
+		if assert.NoError(move(&deck, hands[0])) {
+			assert.True(hands[0].HasCard(&top))
+			for _, card := range hands[0].cards {
+				assert.False(card.IsEmpty())
+			}
+			assert.False(deck.HasCard(&top))
+			assert.Equal(6, hands[0].CardCount())
+		}
+		assert.Error(move(hands[1], hands[0]))
+		assert.NoError(move(hands[1], &deck))
+		assert.Equal(deck.MaxSize()-10, deck.CardCount())
+	}
+}
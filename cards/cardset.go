@@ -0,0 +1,144 @@
+package cards
+
+import (
+	"math/bits"
+)
+
+//CardSet is a set of cards stored as a bitmask of card indices.
+//
+//Sets hold at most one of each card so duplicates from multiple decks are collapsed.
+//Membership and set operations are O(1) making CardSet suited to tight loops
+//where Deck.HasCard and Hand.HasCard would scan every card.
+type CardSet uint64
+
+//IndexCount is the number of card indices, one for each card in NewStandardDeck(true).
+const IndexCount = 54
+
+//Index returns the position of the card in NewStandardDeck(true), from 0 to 53.
+//
+//Clubs are 0-12, Spades 13-25, Diamonds 26-38 and Hearts 39-51 each from Ace to King,
+//the LittleJoker is 52 and the BigJoker is 53.
+//Returns -1 if the card is not valid.
+func (c Card) Index() int {
+	switch {
+	case c.rank == LittleJoker && c.suit == BlackJokerSuit:
+		return 52
+	case c.rank == BigJoker && c.suit == RedJokerSuit:
+		return 53
+	case c.rank < Ace || c.rank > King:
+		return -1
+	}
+	switch c.suit {
+	case ClubsSuit:
+		return int(c.rank) - 1
+	case SpadesSuit:
+		return 13 + int(c.rank) - 1
+	case DiamondsSuit:
+		return 26 + int(c.rank) - 1
+	case HeartsSuit:
+		return 39 + int(c.rank) - 1
+	}
+	return -1
+}
+
+//CardFromIndex returns the card at the given index of NewStandardDeck(true).
+//
+//Errors if the index is not between 0 and 53.
+func CardFromIndex(index int) (Card, error) {
+	if index < 0 || index >= IndexCount {
+		return Card{}, &OutOfRange{indices: []int{index}}
+	}
+	return cardFromIndex(index), nil
+}
+
+func cardFromIndex(index int) Card {
+	switch {
+	case index == 52:
+		return Card{suit: BlackJokerSuit, rank: LittleJoker}
+	case index == 53:
+		return Card{suit: RedJokerSuit, rank: BigJoker}
+	}
+	suits := allSuits()
+	return Card{suit: suits[index/13], rank: Rank(index%13 + 1)}
+}
+
+//NewCardSet returns a set containing the given cards.
+func NewCardSet(cards ...Card) CardSet {
+	var s CardSet
+	s.Add(cards...)
+	return s
+}
+
+//Add puts the cards into the set, invalid cards are ignored.
+func (s *CardSet) Add(cards ...Card) {
+	for _, card := range cards {
+		if index := card.Index(); index >= 0 {
+			*s |= 1 << uint(index)
+		}
+	}
+}
+
+//Remove takes the cards out of the set.
+func (s *CardSet) Remove(cards ...Card) {
+	for _, card := range cards {
+		if index := card.Index(); index >= 0 {
+			*s &^= 1 << uint(index)
+		}
+	}
+}
+
+//Contains returns true if the card is in the set.
+func (s CardSet) Contains(card Card) bool {
+	index := card.Index()
+	return index >= 0 && s&(1<<uint(index)) != 0
+}
+
+//Union returns the cards in either set.
+func (s CardSet) Union(other CardSet) CardSet {
+	return s | other
+}
+
+//Intersect returns the cards in both sets.
+func (s CardSet) Intersect(other CardSet) CardSet {
+	return s & other
+}
+
+//Difference returns the cards in s that are not in other.
+func (s CardSet) Difference(other CardSet) CardSet {
+	return s &^ other
+}
+
+//Count returns the number of cards in the set.
+func (s CardSet) Count() int {
+	return bits.OnesCount64(uint64(s))
+}
+
+//Cards returns the cards in the set ordered by index.
+func (s CardSet) Cards() []Card {
+	cards := make([]Card, 0, s.Count())
+	for rest := uint64(s); rest != 0; rest &= rest - 1 {
+		cards = append(cards, cardFromIndex(bits.TrailingZeros64(rest)))
+	}
+	return cards
+}
+
+//Pile returns a Pile holding the cards in the set with a maxSize of the set's count.
+func (s CardSet) Pile() Pile {
+	cards := s.Cards()
+	return Pile{cards: cards, maxSize: len(cards)}
+}
+
+//Hand returns a Hand holding the cards in the set with a maxSize of the set's count.
+func (s CardSet) Hand() Hand {
+	return Hand{Pile: s.Pile()}
+}
+
+//Deck returns a Deck holding the cards in the set with a maxSize of the set's count.
+func (s CardSet) Deck() Deck {
+	return Deck{Pile: s.Pile()}
+}
+
+//CardSet returns the set of cards in the pile.
+func (p *Pile) CardSet() CardSet {
+	return NewCardSet(p.cards...)
+}
@@ -0,0 +1,89 @@
+package cards
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Card_Index(t *testing.T) {
+	assert := assert.New(t)
+	deck := NewStandardDeck(true)
+	for i, card := range deck.cards {
+		assert.Equal(i, card.Index())
+		got, err := CardFromIndex(i)
+		if assert.NoError(err) {
+			assert.Equal(card, got)
+		}
+	}
+	assert.Equal(-1, Card{}.Index())
+	assert.Equal(-1, Card{suit: HeartsSuit, rank: BigJoker}.Index())
+	assert.Equal(-1, Card{suit: RedJokerSuit, rank: LittleJoker}.Index())
+	_, err := CardFromIndex(IndexCount)
+	assert.IsType(&OutOfRange{}, err)
+	_, err = CardFromIndex(-1)
+	assert.IsType(&OutOfRange{}, err)
+}
+
+func TestCardSet(t *testing.T) {
+	assert := assert.New(t)
+	deck := NewStandardDeck(true)
+	t.Run("add remove contains", func(t *testing.T) {
+		var s CardSet
+		s.Add(deck.cards[0], deck.cards[53], Card{})
+		assert.Equal(2, s.Count())
+		assert.True(s.Contains(deck.cards[0]))
+		assert.True(s.Contains(deck.cards[53]))
+		assert.False(s.Contains(deck.cards[1]))
+		assert.False(s.Contains(Card{}))
+		s.Remove(deck.cards[0])
+		assert.Equal(1, s.Count())
+		assert.False(s.Contains(deck.cards[0]))
+	})
+	t.Run("operations", func(t *testing.T) {
+		a := NewCardSet(deck.cards[0], deck.cards[1], deck.cards[2])
+		b := NewCardSet(deck.cards[2], deck.cards[3])
+		assert.Equal(NewCardSet(deck.cards[:4]...), a.Union(b))
+		assert.Equal(NewCardSet(deck.cards[2]), a.Intersect(b))
+		assert.Equal(NewCardSet(deck.cards[0], deck.cards[1]), a.Difference(b))
+	})
+	t.Run("cards", func(t *testing.T) {
+		s := NewCardSet(deck.cards[40], deck.cards[3], deck.cards[52])
+		assert.Equal([]Card{deck.cards[3], deck.cards[40], deck.cards[52]}, s.Cards())
+	})
+	t.Run("deck and hand", func(t *testing.T) {
+		full := deck.CardSet()
+		assert.Equal(IndexCount, full.Count())
+		converted := full.Deck()
+		assert.Equal(deck.cards, converted.cards)
+		assert.Equal(IndexCount, converted.maxSize)
+		standard := NewStandardDeck(false)
+		hands, err := standard.Deal(1, 5)
+		if assert.NoError(err) {
+			s := hands[0].CardSet()
+			assert.Equal(5, s.Count())
+			hand := s.Hand()
+			assert.Equal(hands[0].cards, hand.cards)
+			assert.Equal(5, hand.maxSize)
+		}
+	})
+}
+
+func BenchmarkDeckHasCard(b *testing.B) {
+	deck := NewStandardDeck(true)
+	card := deck.cards[len(deck.cards)-1]
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		deck.HasCard(&card)
+	}
+}
+
+func BenchmarkCardSetContains(b *testing.B) {
+	deck := NewStandardDeck(true)
+	s := deck.CardSet()
+	card := deck.cards[len(deck.cards)-1]
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Contains(card)
+	}
+}
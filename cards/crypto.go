@@ -0,0 +1,56 @@
+package cards
+
+import (
+	"bufio"
+	crand "crypto/rand"
+	"encoding/binary"
+	"io"
+	"math/rand"
+)
+
+//CryptoShuffler is a CardShuffler that draws its randomness from crypto/rand.
+//
+//Each card is swapped using an unbiased Fisher-Yates shuffle where random indices
+//are chosen by rejection sampling, so every order of the cards is equally likely
+//and can not be predicted from earlier shuffles.
+//The math/rand source passed to ShuffleCards is ignored.
+type CryptoShuffler struct {
+	//Reader is the source of random bytes, crypto/rand.Reader when nil.
+	Reader io.Reader
+}
+
+//ShuffleCards implements CardShuffler.
+//
+//ShuffleCards panics if the random bytes can not be read.
+func (s CryptoShuffler) ShuffleCards(cards []Card, _ *rand.Rand) {
+	if len(cards) < 2 {
+		return
+	}
+	reader := s.Reader
+	if reader == nil {
+		reader = crand.Reader
+	}
+	buffered := bufio.NewReaderSize(reader, 8*len(cards))
+	for i := len(cards) - 1; i > 0; i-- {
+		j := uniformIndex(buffered, uint64(i+1))
+		cards[i], cards[j] = cards[j], cards[i]
+	}
+}
+
+//uniformIndex returns a uniformly random number in [0, n).
+//
+//Values below 2^64 mod n are rejected so that every remainder
+//is reached by the same number of values.
+func uniformIndex(reader io.Reader, n uint64) uint64 {
+	threshold := -n % n
+	var buf [8]byte
+	for {
+		if _, err := io.ReadFull(reader, buf[:]); err != nil {
+			panic(err)
+		}
+		v := binary.LittleEndian.Uint64(buf[:])
+		if v >= threshold {
+			return v % n
+		}
+	}
+}
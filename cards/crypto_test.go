@@ -0,0 +1,51 @@
+package cards
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCryptoShuffler(t *testing.T) {
+	assert := assert.New(t)
+	t.Run("permutation", func(t *testing.T) {
+		deck := NewStandardDeck(true)
+		deck.SetShuffler(CryptoShuffler{})
+		deck.Shuffle()
+		assert.Len(deck.cards, 54)
+		assert.Equal(IndexCount, deck.CardSet().Count())
+		assert.NotEqual(NewStandardDeck(true).cards, deck.cards)
+	})
+	t.Run("deterministic reader", func(t *testing.T) {
+		data := make([]byte, 8*64)
+		for i := range data {
+			data[i] = byte(i * 31)
+		}
+		a, b := NewStandardDeck(false), NewStandardDeck(false)
+		CryptoShuffler{Reader: bytes.NewReader(data)}.ShuffleCards(a.cards, nil)
+		CryptoShuffler{Reader: bytes.NewReader(data)}.ShuffleCards(b.cards, nil)
+		assert.Equal(a.cards, b.cards)
+	})
+	t.Run("short reader", func(t *testing.T) {
+		deck := NewStandardDeck(false)
+		assert.Panics(func() {
+			CryptoShuffler{Reader: bytes.NewReader([]byte{1, 2, 3})}.ShuffleCards(deck.cards, nil)
+		})
+	})
+}
+
+func TestUniformIndex(t *testing.T) {
+	assert := assert.New(t)
+	var data []byte
+	//2^64 mod 3 is 1 so 0 is rejected and 5 is used.
+	for _, v := range []uint64{0, 5} {
+		var buf [8]byte
+		binary.LittleEndian.PutUint64(buf[:], v)
+		data = append(data, buf[:]...)
+	}
+	reader := bytes.NewReader(data)
+	assert.Equal(uint64(2), uniformIndex(reader, 3))
+	assert.Equal(0, reader.Len())
+}
@@ -1,6 +1,7 @@
 package cards
 
 import (
+	"math/rand"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -91,6 +92,59 @@ func TestShuffle(t *testing.T) {
 	assert.Less(failed, 2)
 }
 
+func TestShuffleUniform(t *testing.T) {
+	assert := assert.New(t)
+	t.Run("permutations", func(t *testing.T) {
+		//Every order of 4 cards should appear equally often.
+		//The chi-squared critical value for 23 degrees of freedom at p=0.001 is 49.73.
+		const trials = 24000
+		source := NewStandardDeck(false)
+		source.SetSource(rand.NewSource(1))
+		counts := map[[4]Card]int{}
+		for i := 0; i < trials; i++ {
+			deck := Deck{Pile: Pile{cards: append([]Card{}, source.cards[:4]...), maxSize: 4, rand: source.random()}}
+			deck.Shuffle()
+			counts[[4]Card{deck.cards[0], deck.cards[1], deck.cards[2], deck.cards[3]}]++
+		}
+		assert.Len(counts, 24)
+		expected := float64(trials) / 24
+		var chiSquared float64
+		for _, count := range counts {
+			chiSquared += (float64(count) - expected) * (float64(count) - expected) / expected
+		}
+		assert.Less(chiSquared, 49.73)
+	})
+	t.Run("positions", func(t *testing.T) {
+		//Each card should land in each position of a full deck equally often.
+		//The chi-squared critical value for 51 degrees of freedom at p=0.001 is 87.97.
+		const trials = 20000
+		var counts [52][52]int
+		deck := NewStandardDeck(false)
+		deck.SetSource(rand.NewSource(2))
+		for i := 0; i < trials; i++ {
+			deck.Shuffle()
+			for position, card := range deck.cards {
+				counts[card.Index()][position]++
+			}
+		}
+		expected := float64(trials) / 52
+		for _, positions := range counts {
+			var chiSquared float64
+			for _, count := range positions {
+				chiSquared += (float64(count) - expected) * (float64(count) - expected) / expected
+			}
+			assert.Less(chiSquared, 87.97)
+		}
+	})
+}
+
+func BenchmarkShuffle(b *testing.B) {
+	deck := NewStandardDeck(false)
+	for i := 0; i < b.N; i++ {
+		deck.Shuffle()
+	}
+}
+
 func Test_Deck_Pick(t *testing.T) {
 	assert := assert.New(t)
 	t.Run("multiple", func(t *testing.T) {
@@ -187,9 +241,27 @@ func TestDeal(t *testing.T) {
 	t.Run("not enough cards", func(t *testing.T) {
 		deck := NewStandardDeck(false)
 		_, err := deck.Deal(30, 2)
-		assert.Error(err)
+		assert.IsType(&NotEnough{}, err)
+	})
+	t.Run("overflow", func(t *testing.T) {
+		deck := NewStandardDeck(false)
+		maxInt := int(^uint(0) >> 1)
+		_, err := deck.Deal(maxInt, 2)
+		assert.IsType(&NotEnough{}, err)
+		_, err = deck.Deal(maxInt/2+1, 4)
+		assert.IsType(&NotEnough{}, err)
+		assert.Equal(52, deck.CardCount())
+	})
+	t.Run("negative", func(t *testing.T) {
+		deck := NewStandardDeck(false)
+		_, err := deck.Deal(-1, 5)
+		assert.IsType(&OutOfRange{}, err)
+		_, err = deck.Deal(-2, -5)
+		assert.IsType(&OutOfRange{}, err)
+		_, err = deck.Deal(4, -1)
+		assert.IsType(&OutOfRange{}, err)
+		assert.Equal(52, deck.CardCount())
 	})
-
 }
 
 func Test_Deck_CardCount(t *testing.T) {
@@ -331,30 +403,30 @@ func Test_Deck_Place(t *testing.T) {
 	deck := NewStandardDeck(false)
 	t.Run("multiple", func(t *testing.T) {
 		validIndices := []int{0, 1, 2}
-		emptyDeck := Deck{cards: make([]Card, 3), maxSize: 8}
+		emptyDeck := Deck{Pile: Pile{cards: make([]Card, 3), maxSize: 8}}
 		if assert.NoError(emptyDeck.Place(deck.cards[:3], validIndices)) {
 			assert.Equal(deck.cards[:3], emptyDeck.cards[:3])
 		}
 	})
 	t.Run("invalid index", func(t *testing.T) {
-		emptyDeck := Deck{cards: make([]Card, 5), maxSize: 10}
+		emptyDeck := Deck{Pile: Pile{cards: make([]Card, 5), maxSize: 10}}
 		invalidIndices := []int{len(emptyDeck.cards) + 1}
 		assert.Error(deck.Place([]Card{}, invalidIndices))
 	})
 	t.Run("max exceeded", func(t *testing.T) {
 		validIndices := []int{0, 1, 2}
-		partiallyFilledDeck := Deck{cards: make([]Card, 3), maxSize: 5}
+		partiallyFilledDeck := Deck{Pile: Pile{cards: make([]Card, 3), maxSize: 5}}
 		partiallyFilledDeck.cards[0] = deck.cards[15]
 		assert.Error(deck.Place(deck.cards[:3], validIndices))
 	})
 	t.Run("mismatched inputs", func(t *testing.T) {
 		validIndices := []int{0}
-		partiallyFilledDeck := Deck{cards: make([]Card, 3), maxSize: 3}
+		partiallyFilledDeck := Deck{Pile: Pile{cards: make([]Card, 3), maxSize: 3}}
 		partiallyFilledDeck.cards[0] = deck.cards[15]
 		assert.Error(deck.Place(deck.cards[:3], validIndices))
 	})
 	t.Run("repeated", func(t *testing.T) {
-		emptyDeck := Deck{cards: make([]Card, 5), maxSize: 5}
+		emptyDeck := Deck{Pile: Pile{cards: make([]Card, 5), maxSize: 5}}
 		repeatedIndices := []int{1, 1, 1, 1}
 		assert.Error(emptyDeck.Place(deck.cards[:4], repeatedIndices))
 	})
@@ -364,21 +436,21 @@ func TestPlaceTop(t *testing.T) {
 	assert := assert.New(t)
 	deck := NewStandardDeck(false)
 	t.Run("space available", func(t *testing.T) {
-		partiallyFilledDeck := Deck{cards: make([]Card, 3), maxSize: 5}
+		partiallyFilledDeck := Deck{Pile: Pile{cards: make([]Card, 3), maxSize: 5}}
 		if assert.NoError(partiallyFilledDeck.PlaceTop(deck.cards[0])) {
 			assert.Equal(deck.cards[0], partiallyFilledDeck.cards[0])
 			assert.Len(partiallyFilledDeck.cards, 4)
 		}
 	})
 	t.Run("no space", func(t *testing.T) {
-		partiallyFilledDeck := Deck{cards: make([]Card, 3), maxSize: 3}
+		partiallyFilledDeck := Deck{Pile: Pile{cards: make([]Card, 3), maxSize: 3}}
 		assert.Error(partiallyFilledDeck.PlaceTop(deck.cards[0]))
 	})
 }
 
 func BenchmarkPlaceTop(b *testing.B) {
 	for i := 0; i < b.N; i++ {
-		partiallyFilledDeck := Deck{cards: make([]Card, 3), maxSize: 5}
+		partiallyFilledDeck := Deck{Pile: Pile{cards: make([]Card, 3), maxSize: 5}}
 		partiallyFilledDeck.PlaceTop(Card{})
 	}
 }
@@ -387,14 +459,14 @@ func TestPlaceBottom(t *testing.T) {
 	assert := assert.New(t)
 	deck := NewStandardDeck(false)
 	t.Run("space available", func(t *testing.T) {
-		partiallyFilledDeck := Deck{cards: make([]Card, 3), maxSize: 5}
+		partiallyFilledDeck := Deck{Pile: Pile{cards: make([]Card, 3), maxSize: 5}}
 		if assert.NoError(partiallyFilledDeck.PlaceBottom(deck.cards[0])) {
 			assert.Equal(deck.cards[0], partiallyFilledDeck.cards[len(partiallyFilledDeck.cards)-1])
 			assert.Len(partiallyFilledDeck.cards, 4)
 		}
 	})
 	t.Run("no space", func(t *testing.T) {
-		partiallyFilledDeck := Deck{cards: make([]Card, 3), maxSize: 3}
+		partiallyFilledDeck := Deck{Pile: Pile{cards: make([]Card, 3), maxSize: 3}}
 		assert.Error(partiallyFilledDeck.PlaceBottom(deck.cards[0]))
 	})
 }
@@ -403,7 +475,7 @@ func TestPlaceRandom(t *testing.T) {
 	assert := assert.New(t)
 	deck := NewStandardDeck(false)
 	t.Run("space available", func(t *testing.T) {
-		partiallyFilledDeck := Deck{cards: make([]Card, 3), maxSize: 5}
+		partiallyFilledDeck := Deck{Pile: Pile{cards: make([]Card, 3), maxSize: 5}}
 		if assert.NoError(partiallyFilledDeck.PlaceRandom(deck.cards[0])) {
 			if assert.Len(partiallyFilledDeck.cards, 4) {
 				assert.Contains(partiallyFilledDeck.cards, deck.cards[0])
@@ -411,7 +483,57 @@ func TestPlaceRandom(t *testing.T) {
 		}
 	})
 	t.Run("no space", func(t *testing.T) {
-		partiallyFilledDeck := Deck{cards: make([]Card, 3), maxSize: 3}
+		partiallyFilledDeck := Deck{Pile: Pile{cards: make([]Card, 3), maxSize: 3}}
 		assert.Error(partiallyFilledDeck.PlaceRandom(deck.cards[0]))
 	})
 }
+
+func Test_Deck_SetSource(t *testing.T) {
+	assert := assert.New(t)
+	seeded := func() Deck {
+		deck := NewStandardDeck(true)
+		deck.SetSource(rand.NewSource(42))
+		return deck
+	}
+	t.Run("shuffle", func(t *testing.T) {
+		a, b := seeded(), seeded()
+		a.Shuffle()
+		b.Shuffle()
+		assert.Equal(a.cards, b.cards)
+		c := seeded()
+		c.SetSource(rand.NewSource(43))
+		c.Shuffle()
+		assert.NotEqual(a.cards, c.cards)
+	})
+	t.Run("pick and place random", func(t *testing.T) {
+		a, b := seeded(), seeded()
+		for i := 0; i < 10; i++ {
+			cardA, errA := a.PickRandom()
+			cardB, errB := b.PickRandom()
+			if assert.NoError(errA) && assert.NoError(errB) {
+				assert.Equal(cardA, cardB)
+			}
+			assert.NoError(a.PlaceRandom(cardA))
+			assert.NoError(b.PlaceRandom(cardB))
+		}
+		assert.Equal(a.cards, b.cards)
+	})
+	t.Run("deal", func(t *testing.T) {
+		a, b := seeded(), seeded()
+		a.Shuffle()
+		b.Shuffle()
+		handsA, errA := a.Deal(4, 5)
+		handsB, errB := b.Deal(4, 5)
+		if assert.NoError(errA) && assert.NoError(errB) {
+			for i := range handsA {
+				//Hands do not share a source with the deck or each other.
+				assert.True(handsA[i].rand != a.rand)
+				assert.True(i == 0 || handsA[i].rand != handsA[i-1].rand)
+				assert.Equal(handsA[i].cards, handsB[i].cards)
+				cardA, _ := handsA[i].PickRandom()
+				cardB, _ := handsB[i].PickRandom()
+				assert.Equal(cardA, cardB)
+			}
+		}
+	})
+}
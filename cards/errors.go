@@ -48,3 +48,48 @@ type MismatchedInputs struct {
 func (e *MismatchedInputs) Error() string {
 	return fmt.Sprintf("The combination of inputs %v are not valid.", e.inputs)
 }
+
+//InvalidCard signals a rank and suit that do not make a valid card.
+//
+//e.g. creating a card with the rank Ace and the suit Joker.
+type InvalidCard struct {
+	rank Rank
+	suit SuitName
+}
+
+func (e *InvalidCard) Error() string {
+	return fmt.Sprintf("Rank %d and suit %q are not a valid card.", int(e.rank), string(e.suit))
+}
+
+//InvalidNotation signals text that is not a valid card notation.
+//
+//e.g. parsing "1x" as a card.
+type InvalidNotation struct {
+	notations []string
+}
+
+func (e *InvalidNotation) Error() string {
+	return fmt.Sprintf("Notations %q are not valid cards.", e.notations)
+}
+
+//InvalidEncoding signals data that can not be decoded.
+//
+//e.g. unmarshalling a card from an empty byte slice.
+type InvalidEncoding struct {
+	format string
+}
+
+func (e *InvalidEncoding) Error() string {
+	return fmt.Sprintf("Data is not a valid %s encoding.", e.format)
+}
+
+//InvalidOption signals an option with a value that can not be used.
+//
+//e.g. building a deck with zero copies of each card.
+type InvalidOption struct {
+	option string
+}
+
+func (e *InvalidOption) Error() string {
+	return fmt.Sprintf("Option %s is not valid.", e.option)
+}
@@ -0,0 +1,109 @@
+package cards
+
+import (
+	"unicode"
+)
+
+//CardBack is the Unicode playing card back, U+1F0A0.
+const CardBack rune = 0x1F0A0
+
+//Offsets into the Unicode Playing Cards block.
+//
+//Each suit has a row of 16 code points with the Ace at 1 and the King at 14.
+//The Knight at 12 has no Rank so it is skipped when mapping ranks.
+const (
+	glyphKnight     rune = 0xC
+	glyphRedJoker   rune = 0x1F0BF
+	glyphBlackJoker rune = 0x1F0CF
+)
+
+var glyphSuitRow = map[SuitName]rune{
+	Spades:   0x1F0A0,
+	Hearts:   0x1F0B0,
+	Diamonds: 0x1F0C0,
+	Clubs:    0x1F0D0,
+}
+
+//Glyph returns the code point for the card from the Unicode Playing Cards block e.g. '🂱'.
+//
+//The LittleJoker is the black joker and the BigJoker is the red joker.
+//An empty card returns CardBack and an invalid card returns unicode.ReplacementChar.
+func (c Card) Glyph() rune {
+	switch {
+	case c.IsEmpty():
+		return CardBack
+	case c.rank == LittleJoker && c.suit == BlackJokerSuit:
+		return glyphBlackJoker
+	case c.rank == BigJoker && c.suit == RedJokerSuit:
+		return glyphRedJoker
+	}
+	row, ok := glyphSuitRow[c.suit.name]
+	if !ok || c.rank.isJoker() || !c.rank.isValid() {
+		return unicode.ReplacementChar
+	}
+	offset := rune(c.rank)
+	if offset >= glyphKnight {
+		offset++
+	}
+	return row + offset
+}
+
+//ParseGlyph returns the card for a code point from the Unicode Playing Cards block.
+//
+//Errors with InvalidNotation for card backs, knights, the white joker, trumps
+//and any code point outside the block.
+func ParseGlyph(r rune) (Card, error) {
+	card, ok := parseGlyph(r)
+	if !ok {
+		return Card{}, &InvalidNotation{notations: []string{string(r)}}
+	}
+	return card, nil
+}
+
+//ParseGlyphs returns the cards for each playing card code point in s.
+//
+//White space between glyphs is ignored.
+//Errors with InvalidNotation listing every invalid glyph.
+func ParseGlyphs(s string) ([]Card, error) {
+	cards := []Card{}
+	invalid := []string{}
+	for _, r := range s {
+		if unicode.IsSpace(r) {
+			continue
+		}
+		card, ok := parseGlyph(r)
+		if !ok {
+			invalid = append(invalid, string(r))
+			continue
+		}
+		cards = append(cards, card)
+	}
+	if len(invalid) > 0 {
+		return nil, &InvalidNotation{notations: invalid}
+	}
+	return cards, nil
+}
+
+func parseGlyph(r rune) (Card, bool) {
+	switch r {
+	case glyphBlackJoker:
+		return Card{suit: BlackJokerSuit, rank: LittleJoker}, true
+	case glyphRedJoker:
+		return Card{suit: RedJokerSuit, rank: BigJoker}, true
+	}
+	for name, row := range glyphSuitRow {
+		offset := r - row
+		if offset < 1 || offset > 0xE || offset == glyphKnight {
+			continue
+		}
+		if offset > glyphKnight {
+			offset--
+		}
+		suit, ok := suitFor(Rank(offset), name)
+		if !ok {
+			return Card{}, false
+		}
+		return Card{suit: suit, rank: Rank(offset)}, true
+	}
+	return Card{}, false
+}
@@ -0,0 +1,63 @@
+package cards
+
+import (
+	"fmt"
+	"testing"
+	"unicode"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Card_Glyph(t *testing.T) {
+	assert := assert.New(t)
+	expected := map[string]rune{
+		"As": '🂡',
+		"Th": '🂺',
+		"Jd": '🃋',
+		"Qc": '🃝',
+		"Kc": '🃞',
+		"jk": '🃏',
+		"JK": '🂿',
+	}
+	for notation, glyph := range expected {
+		card, err := ParseCard(notation)
+		if assert.NoError(err) {
+			assert.Equal(glyph, card.Glyph(), notation)
+		}
+	}
+	assert.Equal(CardBack, Card{}.Glyph())
+	assert.Equal(unicode.ReplacementChar, Card{rank: Ace, suit: RedJokerSuit}.Glyph())
+	ace, _ := NewCard(Ace, Hearts)
+	assert.Equal("🂱", fmt.Sprintf("%c", ace))
+}
+
+func TestParseGlyph(t *testing.T) {
+	assert := assert.New(t)
+	t.Run("round trip", func(t *testing.T) {
+		deck := NewStandardDeck(true)
+		for _, expected := range deck.cards {
+			card, err := ParseGlyph(expected.Glyph())
+			if assert.NoError(err) {
+				assert.Equal(expected, card)
+			}
+		}
+	})
+	t.Run("invalid", func(t *testing.T) {
+		for _, r := range []rune{CardBack, '🂬', '🃟', '🃠', 'A'} {
+			_, err := ParseGlyph(r)
+			assert.IsType(&InvalidNotation{}, err, string(r))
+		}
+	})
+}
+
+func TestParseGlyphs(t *testing.T) {
+	assert := assert.New(t)
+	cards, err := ParseGlyphs("🂡 🂮🃏")
+	if assert.NoError(err) && assert.Len(cards, 3) {
+		assert.Equal("As Ks jk", fmt.Sprintf("%v %v %v", cards[0], cards[1], cards[2]))
+	}
+	_, err = ParseGlyphs("🂡🂬🂠")
+	if assert.IsType(&InvalidNotation{}, err) {
+		assert.Equal([]string{"🂬", "🂠"}, err.(*InvalidNotation).notations)
+	}
+}
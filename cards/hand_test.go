@@ -1,6 +1,7 @@
 package cards
 
 import (
+	"math/rand"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -208,3 +209,22 @@ func Test_Hand_Place(t *testing.T) {
 		}
 	})
 }
+
+func Test_Hand_SetSource(t *testing.T) {
+	assert := assert.New(t)
+	deck := NewStandardDeck(false)
+	hands, err := deck.Deal(2, 10)
+	if assert.NoError(err) {
+		a, b := hands[0], hands[1]
+		b.cards = append([]Card{}, a.cards...)
+		a.SetSource(rand.NewSource(7))
+		b.SetSource(rand.NewSource(7))
+		for i := 0; i < 5; i++ {
+			cardA, errA := a.PickRandom()
+			cardB, errB := b.PickRandom()
+			if assert.NoError(errA) && assert.NoError(errB) {
+				assert.Equal(cardA, cardB)
+			}
+		}
+	}
+}
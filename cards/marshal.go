@@ -0,0 +1,254 @@
+package cards
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+//Cards are encoded as their short notation in text and JSON,
+//and as a single byte holding the rank and suit in binary.
+//
+//Piles, and the Hands and Decks built on them, include their maxSize. In text they are written as the maxSize
+//followed by a colon and the cards e.g. "5:Ah Kd", in JSON as an object and
+//in binary as a uvarint maxSize followed by one byte per card.
+
+//pileJSON is the JSON form of a Pile.
+type pileJSON struct {
+	MaxSize int    `json:"maxSize"`
+	Cards   []Card `json:"cards"`
+}
+
+//MarshalText implements encoding.TextMarshaler.
+func (c Card) MarshalText() ([]byte, error) {
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+	return []byte(c.String()), nil
+}
+
+//UnmarshalText implements encoding.TextUnmarshaler.
+func (c *Card) UnmarshalText(text []byte) error {
+	card, err := ParseCard(string(text))
+	if err != nil {
+		return err
+	}
+	*c = card
+	return nil
+}
+
+//MarshalJSON implements json.Marshaler.
+func (c Card) MarshalJSON() ([]byte, error) {
+	text, err := c.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+//UnmarshalJSON implements json.Unmarshaler.
+func (c *Card) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+	return c.UnmarshalText([]byte(text))
+}
+
+//MarshalBinary implements encoding.BinaryMarshaler.
+func (c Card) MarshalBinary() ([]byte, error) {
+	b, err := c.encodeByte()
+	if err != nil {
+		return nil, err
+	}
+	return []byte{b}, nil
+}
+
+//UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (c *Card) UnmarshalBinary(data []byte) error {
+	if len(data) != 1 {
+		return &InvalidEncoding{format: "card"}
+	}
+	card, err := decodeByte(data[0])
+	if err != nil {
+		return err
+	}
+	*c = card
+	return nil
+}
+
+//validate errors if the card's rank and suit are not a valid combination.
+func (c *Card) validate() error {
+	suit, ok := suitFor(c.rank, c.suit.name)
+	if !ok || suit != c.suit {
+		return &InvalidCard{rank: c.rank, suit: c.suit.name}
+	}
+	return nil
+}
+
+//encodeByte packs the rank into the high bits and the suit's position in allSuits into the low 3 bits.
+func (c *Card) encodeByte() (byte, error) {
+	if err := c.validate(); err != nil {
+		return 0, err
+	}
+	for i, suit := range allSuits() {
+		if suit == c.suit {
+			return byte(c.rank)<<3 | byte(i), nil
+		}
+	}
+	return 0, &InvalidCard{rank: c.rank, suit: c.suit.name}
+}
+
+func decodeByte(b byte) (Card, error) {
+	suits := allSuits()
+	rank := Rank(b >> 3)
+	index := int(b & 7)
+	if index >= len(suits) {
+		return Card{}, &InvalidEncoding{format: "card"}
+	}
+	card := Card{suit: suits[index], rank: rank}
+	if err := card.validate(); err != nil {
+		return Card{}, err
+	}
+	return card, nil
+}
+
+//MarshalText implements encoding.TextMarshaler.
+func (p Pile) MarshalText() ([]byte, error) {
+	return marshalPileText(p.cards, p.maxSize)
+}
+
+//UnmarshalText implements encoding.TextUnmarshaler.
+func (p *Pile) UnmarshalText(text []byte) error {
+	cards, maxSize, err := unmarshalPileText(text)
+	if err != nil {
+		return err
+	}
+	p.cards, p.maxSize = cards, maxSize
+	return nil
+}
+
+//MarshalJSON implements json.Marshaler.
+func (p Pile) MarshalJSON() ([]byte, error) {
+	return json.Marshal(pileJSON{MaxSize: p.maxSize, Cards: p.cards})
+}
+
+//UnmarshalJSON implements json.Unmarshaler.
+func (p *Pile) UnmarshalJSON(data []byte) error {
+	cards, maxSize, err := unmarshalPileJSON(data)
+	if err != nil {
+		return err
+	}
+	p.cards, p.maxSize = cards, maxSize
+	return nil
+}
+
+//MarshalBinary implements encoding.BinaryMarshaler.
+func (p Pile) MarshalBinary() ([]byte, error) {
+	return marshalPileBinary(p.cards, p.maxSize)
+}
+
+//UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (p *Pile) UnmarshalBinary(data []byte) error {
+	cards, maxSize, err := unmarshalPileBinary(data)
+	if err != nil {
+		return err
+	}
+	p.cards, p.maxSize = cards, maxSize
+	return nil
+}
+
+func marshalPileText(cards []Card, maxSize int) ([]byte, error) {
+	var b strings.Builder
+	b.WriteString(strconv.Itoa(maxSize))
+	b.WriteByte(':')
+	for i, card := range cards {
+		text, err := card.MarshalText()
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		b.Write(text)
+	}
+	return []byte(b.String()), nil
+}
+
+func unmarshalPileText(text []byte) ([]Card, int, error) {
+	parts := strings.SplitN(string(text), ":", 2)
+	if len(parts) != 2 {
+		return nil, 0, &InvalidEncoding{format: "text"}
+	}
+	maxSize, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+	if err != nil || maxSize < 0 {
+		return nil, 0, &InvalidEncoding{format: "text"}
+	}
+	cards, err := ParseCards(parts[1])
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := checkPileSize(cards, maxSize); err != nil {
+		return nil, 0, err
+	}
+	return cards, maxSize, nil
+}
+
+func unmarshalPileJSON(data []byte) ([]Card, int, error) {
+	var pile pileJSON
+	if err := json.Unmarshal(data, &pile); err != nil {
+		return nil, 0, err
+	}
+	if pile.MaxSize < 0 {
+		return nil, 0, &InvalidEncoding{format: "json"}
+	}
+	if err := checkPileSize(pile.Cards, pile.MaxSize); err != nil {
+		return nil, 0, err
+	}
+	return pile.Cards, pile.MaxSize, nil
+}
+
+func marshalPileBinary(cards []Card, maxSize int) ([]byte, error) {
+	if maxSize < 0 {
+		return nil, &InvalidEncoding{format: "binary"}
+	}
+	data := make([]byte, binary.MaxVarintLen64, binary.MaxVarintLen64+len(cards))
+	data = data[:binary.PutUvarint(data, uint64(maxSize))]
+	for _, card := range cards {
+		b, err := card.encodeByte()
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, b)
+	}
+	return data, nil
+}
+
+func unmarshalPileBinary(data []byte) ([]Card, int, error) {
+	size, n := binary.Uvarint(data)
+	if n <= 0 || size > uint64(int(^uint(0)>>1)) {
+		return nil, 0, &InvalidEncoding{format: "binary"}
+	}
+	maxSize := int(size)
+	cards := make([]Card, len(data)-n)
+	for i, b := range data[n:] {
+		card, err := decodeByte(b)
+		if err != nil {
+			return nil, 0, err
+		}
+		cards[i] = card
+	}
+	if err := checkPileSize(cards, maxSize); err != nil {
+		return nil, 0, err
+	}
+	return cards, maxSize, nil
+}
+
+//checkPileSize errors if there are more cards than the maxSize allows.
+func checkPileSize(cards []Card, maxSize int) error {
+	if len(cards) > maxSize {
+		return &NotEnough{requested: len(cards), available: maxSize}
+	}
+	return nil
+}
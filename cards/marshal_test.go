@@ -0,0 +1,157 @@
+package cards
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Card_Marshal(t *testing.T) {
+	assert := assert.New(t)
+	deck := NewStandardDeck(true)
+	t.Run("json", func(t *testing.T) {
+		data, err := json.Marshal(deck.cards[0])
+		if assert.NoError(err) {
+			assert.Equal(`"Ac"`, string(data))
+		}
+		for _, expected := range deck.cards {
+			data, err := json.Marshal(expected)
+			if assert.NoError(err) {
+				var card Card
+				if assert.NoError(json.Unmarshal(data, &card)) {
+					assert.Equal(expected, card)
+				}
+			}
+		}
+	})
+	t.Run("text", func(t *testing.T) {
+		for _, expected := range deck.cards {
+			text, err := expected.MarshalText()
+			if assert.NoError(err) {
+				var card Card
+				if assert.NoError(card.UnmarshalText(text)) {
+					assert.Equal(expected, card)
+				}
+			}
+		}
+	})
+	t.Run("binary", func(t *testing.T) {
+		for _, expected := range deck.cards {
+			data, err := expected.MarshalBinary()
+			if assert.NoError(err) && assert.Len(data, 1) {
+				var card Card
+				if assert.NoError(card.UnmarshalBinary(data)) {
+					assert.Equal(expected, card)
+				}
+			}
+		}
+	})
+	t.Run("invalid", func(t *testing.T) {
+		_, err := json.Marshal(Card{})
+		assert.Error(err)
+		var card Card
+		assert.Error(json.Unmarshal([]byte(`"Zz"`), &card))
+		assert.IsType(&InvalidCard{}, card.UnmarshalBinary([]byte{byte(Ace)<<3 | 4}))
+		assert.IsType(&InvalidEncoding{}, card.UnmarshalBinary([]byte{byte(Ace)<<3 | 7}))
+		assert.IsType(&InvalidEncoding{}, card.UnmarshalBinary(nil))
+	})
+}
+
+func Test_Hand_Marshal(t *testing.T) {
+	assert := assert.New(t)
+	deck := NewStandardDeck(false)
+	hands, err := deck.Deal(1, 5)
+	if !assert.NoError(err) {
+		return
+	}
+	hand := hands[0]
+	hand.maxSize = 7
+	t.Run("json", func(t *testing.T) {
+		data, err := json.Marshal(hand)
+		if assert.NoError(err) {
+			assert.JSONEq(`{"maxSize":7,"cards":["Ac","2c","3c","4c","5c"]}`, string(data))
+			var got Hand
+			if assert.NoError(json.Unmarshal(data, &got)) {
+				assert.Equal(hand.cards, got.cards)
+				assert.Equal(hand.maxSize, got.maxSize)
+			}
+		}
+	})
+	t.Run("text", func(t *testing.T) {
+		text, err := hand.MarshalText()
+		if assert.NoError(err) {
+			assert.Equal("7:Ac 2c 3c 4c 5c", string(text))
+			var got Hand
+			if assert.NoError(got.UnmarshalText(text)) {
+				assert.Equal(hand.cards, got.cards)
+				assert.Equal(hand.maxSize, got.maxSize)
+			}
+		}
+	})
+	t.Run("binary", func(t *testing.T) {
+		data, err := hand.MarshalBinary()
+		if assert.NoError(err) {
+			assert.Len(data, 6)
+			var got Hand
+			if assert.NoError(got.UnmarshalBinary(data)) {
+				assert.Equal(hand.cards, got.cards)
+				assert.Equal(hand.maxSize, got.maxSize)
+			}
+		}
+	})
+	t.Run("over max size", func(t *testing.T) {
+		var got Hand
+		assert.IsType(&NotEnough{}, json.Unmarshal([]byte(`{"maxSize":1,"cards":["Ac","2c"]}`), &got))
+		assert.IsType(&NotEnough{}, got.UnmarshalText([]byte("1:Ac 2c")))
+		assert.IsType(&NotEnough{}, got.UnmarshalBinary([]byte{1, byte(Ace) << 3, byte(Two) << 3}))
+	})
+	t.Run("invalid", func(t *testing.T) {
+		var got Hand
+		assert.Error(json.Unmarshal([]byte(`{"maxSize":5,"cards":["Ax"]}`), &got))
+		assert.IsType(&InvalidEncoding{}, got.UnmarshalText([]byte("Ac 2c")))
+		assert.IsType(&InvalidCard{}, got.UnmarshalBinary([]byte{5, byte(LittleJoker) << 3}))
+	})
+	t.Run("negative max size", func(t *testing.T) {
+		var got Hand
+		assert.IsType(&InvalidEncoding{}, json.Unmarshal([]byte(`{"maxSize":-1,"cards":[]}`), &got))
+		assert.IsType(&InvalidEncoding{}, got.UnmarshalText([]byte("-1:")))
+		assert.IsType(&InvalidEncoding{}, got.UnmarshalText([]byte("-1:Ac")))
+	})
+}
+
+func Test_Deck_Marshal(t *testing.T) {
+	assert := assert.New(t)
+	deck := NewStandardDeck(true)
+	deck.Shuffle()
+	t.Run("json", func(t *testing.T) {
+		data, err := json.Marshal(deck)
+		if assert.NoError(err) {
+			var got Deck
+			if assert.NoError(json.Unmarshal(data, &got)) {
+				assert.Equal(deck.cards, got.cards)
+				assert.Equal(deck.maxSize, got.maxSize)
+			}
+		}
+	})
+	t.Run("text", func(t *testing.T) {
+		text, err := deck.MarshalText()
+		if assert.NoError(err) {
+			var got Deck
+			if assert.NoError(got.UnmarshalText(text)) {
+				assert.Equal(deck.cards, got.cards)
+				assert.Equal(deck.maxSize, got.maxSize)
+			}
+		}
+	})
+	t.Run("binary", func(t *testing.T) {
+		data, err := deck.MarshalBinary()
+		if assert.NoError(err) {
+			var got Deck
+			if assert.NoError(got.UnmarshalBinary(data)) {
+				assert.Equal(deck.cards, got.cards)
+				assert.Equal(deck.maxSize, got.maxSize)
+			}
+		}
+	})
+}
@@ -0,0 +1,251 @@
+package cards
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+//Cards, Ranks, SuitNames and SuitColors implement fmt.Formatter with the verbs:
+//
+//	%s, %v  short notation e.g. "Ah", "Td", "jk"
+//	%q      quoted short notation e.g. "\"Ah\""
+//	%l      long notation e.g. "Ace of Hearts"
+//	%u      short notation using suit symbols e.g. "A♥"
+//	%c      the Unicode playing card of a Card e.g. "🂱"
+//
+//The short notation of a card is its rank followed by its suit, jokers are written
+//"jk" for the LittleJoker and "JK" for the BigJoker.
+//Values that have no symbol use their short notation for %u.
+
+var rankShort = map[Rank]string{
+	Ace: "A", Two: "2", Three: "3", Four: "4", Five: "5", Six: "6", Seven: "7",
+	Eight: "8", Nine: "9", Ten: "T", Jack: "J", Queen: "Q", King: "K",
+	LittleJoker: "jk", BigJoker: "JK",
+}
+
+var rankLong = map[Rank]string{
+	Ace: "Ace", Two: "Two", Three: "Three", Four: "Four", Five: "Five", Six: "Six", Seven: "Seven",
+	Eight: "Eight", Nine: "Nine", Ten: "Ten", Jack: "Jack", Queen: "Queen", King: "King",
+	LittleJoker: "Little Joker", BigJoker: "Big Joker",
+}
+
+var suitShort = map[SuitName]string{
+	Clubs: "c", Diamonds: "d", Hearts: "h", Spades: "s", Joker: "j",
+}
+
+var suitLong = map[SuitName]string{
+	Clubs: "Clubs", Diamonds: "Diamonds", Hearts: "Hearts", Spades: "Spades", Joker: "Joker",
+}
+
+var suitSymbol = map[SuitName]string{
+	Clubs: "♣", Diamonds: "♦", Hearts: "♥", Spades: "♠", Joker: "★",
+}
+
+var colorShort = map[SuitColor]string{
+	Red: "r", Black: "b",
+}
+
+var colorLong = map[SuitColor]string{
+	Red: "Red", Black: "Black",
+}
+
+//String returns the short notation of the card e.g. "Ah".
+func (c Card) String() string {
+	if c.rank.isJoker() {
+		return c.rank.String()
+	}
+	return c.rank.String() + c.suit.name.String()
+}
+
+//Format implements fmt.Formatter for the notation verbs.
+func (c Card) Format(f fmt.State, verb rune) {
+	if verb == 'c' {
+		formatNotation(f, 's', "Card", string(c.Glyph()), "", "")
+		return
+	}
+	long := c.rank.long()
+	symbol := c.rank.String()
+	if !c.rank.isJoker() {
+		long += " of " + c.suit.name.long()
+		symbol += c.suit.name.symbol()
+	}
+	formatNotation(f, verb, "Card", c.String(), long, symbol)
+}
+
+//String returns the short notation of the rank e.g. "A".
+func (r Rank) String() string {
+	if short, ok := rankShort[r]; ok {
+		return short
+	}
+	return "?"
+}
+
+func (r Rank) long() string {
+	if long, ok := rankLong[r]; ok {
+		return long
+	}
+	return "Rank(" + strconv.Itoa(int(r)) + ")"
+}
+
+//Format implements fmt.Formatter for the notation verbs and %d.
+func (r Rank) Format(f fmt.State, verb rune) {
+	if verb == 'd' {
+		formatNotation(f, 's', "Rank", strconv.Itoa(int(r)), "", "")
+		return
+	}
+	formatNotation(f, verb, "Rank", r.String(), r.long(), r.String())
+}
+
+//String returns the short notation of the suit name e.g. "h".
+func (n SuitName) String() string {
+	if short, ok := suitShort[n]; ok {
+		return short
+	}
+	return "?"
+}
+
+func (n SuitName) long() string {
+	if long, ok := suitLong[n]; ok {
+		return long
+	}
+	return string(n)
+}
+
+func (n SuitName) symbol() string {
+	if symbol, ok := suitSymbol[n]; ok {
+		return symbol
+	}
+	return n.String()
+}
+
+//Format implements fmt.Formatter for the notation verbs.
+func (n SuitName) Format(f fmt.State, verb rune) {
+	formatNotation(f, verb, "SuitName", n.String(), n.long(), n.symbol())
+}
+
+//String returns the short notation of the suit color e.g. "r".
+func (c SuitColor) String() string {
+	if short, ok := colorShort[c]; ok {
+		return short
+	}
+	return "?"
+}
+
+//Format implements fmt.Formatter for the notation verbs.
+func (c SuitColor) Format(f fmt.State, verb rune) {
+	long, ok := colorLong[c]
+	if !ok {
+		long = string(c)
+	}
+	formatNotation(f, verb, "SuitColor", c.String(), long, c.String())
+}
+
+//formatNotation writes the notation selected by verb, padded to the width of f.
+func formatNotation(f fmt.State, verb rune, typeName, short, long, symbol string) {
+	var s string
+	switch verb {
+	case 's', 'v':
+		s = short
+	case 'q':
+		s = strconv.Quote(short)
+	case 'l':
+		s = long
+	case 'u':
+		s = symbol
+	default:
+		fmt.Fprintf(f, "%%!%c(cards.%s=%s)", verb, typeName, short)
+		return
+	}
+	if width, ok := f.Width(); ok {
+		if pad := width - len([]rune(s)); pad > 0 {
+			if f.Flag('-') {
+				s += strings.Repeat(" ", pad)
+			} else {
+				s = strings.Repeat(" ", pad) + s
+			}
+		}
+	}
+	fmt.Fprint(f, s)
+}
+
+//ParseCard returns the card written in short notation e.g. "As", "10h", "Td" or "JK".
+//
+//Ranks and suits of standard cards are case insensitive.
+//Errors with InvalidNotation if s is not a card.
+func ParseCard(s string) (Card, error) {
+	card, ok := parseCard(strings.TrimSpace(s))
+	if !ok {
+		return Card{}, &InvalidNotation{notations: []string{s}}
+	}
+	return card, nil
+}
+
+//ParseCards returns the cards written in short notation separated by spaces or commas.
+//
+//Errors with InvalidNotation listing every invalid card.
+func ParseCards(s string) ([]Card, error) {
+	fields := strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t' || r == '\n' || r == '\r'
+	})
+	cards := make([]Card, 0, len(fields))
+	invalid := []string{}
+	for _, field := range fields {
+		card, ok := parseCard(field)
+		if !ok {
+			invalid = append(invalid, field)
+			continue
+		}
+		cards = append(cards, card)
+	}
+	if len(invalid) > 0 {
+		return nil, &InvalidNotation{notations: invalid}
+	}
+	return cards, nil
+}
+
+func parseCard(s string) (Card, bool) {
+	switch s {
+	case rankShort[LittleJoker]:
+		return Card{suit: BlackJokerSuit, rank: LittleJoker}, true
+	case rankShort[BigJoker]:
+		return Card{suit: RedJokerSuit, rank: BigJoker}, true
+	}
+	if len(s) < 2 {
+		return Card{}, false
+	}
+	rank, ok := parseRank(strings.ToUpper(s[:len(s)-1]))
+	if !ok {
+		return Card{}, false
+	}
+	var name SuitName
+	switch strings.ToLower(s[len(s)-1:]) {
+	case "c":
+		name = Clubs
+	case "d":
+		name = Diamonds
+	case "h":
+		name = Hearts
+	case "s":
+		name = Spades
+	default:
+		return Card{}, false
+	}
+	suit, ok := suitFor(rank, name)
+	if !ok {
+		return Card{}, false
+	}
+	return Card{suit: suit, rank: rank}, true
+}
+
+func parseRank(s string) (Rank, bool) {
+	if s == "10" {
+		return Ten, true
+	}
+	for _, rank := range allRanks() {
+		if !rank.isJoker() && rankShort[rank] == s {
+			return rank, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,115 @@
+package cards
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Card_Format(t *testing.T) {
+	assert := assert.New(t)
+	aceOfHearts, _ := NewCard(Ace, Hearts)
+	tenOfDiamonds, _ := NewCard(Ten, Diamonds)
+	bigJoker, _ := NewCard(BigJoker, Joker)
+	t.Run("short", func(t *testing.T) {
+		assert.Equal("Ah", aceOfHearts.String())
+		assert.Equal("Td", fmt.Sprint(tenOfDiamonds))
+		assert.Equal("JK", fmt.Sprintf("%s", bigJoker))
+		assert.Equal(`"Ah"`, fmt.Sprintf("%q", aceOfHearts))
+	})
+	t.Run("long", func(t *testing.T) {
+		assert.Equal("Ace of Hearts", fmt.Sprintf("%l", aceOfHearts))
+		assert.Equal("Ten of Diamonds", fmt.Sprintf("%l", tenOfDiamonds))
+		assert.Equal("Big Joker", fmt.Sprintf("%l", bigJoker))
+	})
+	t.Run("symbol", func(t *testing.T) {
+		assert.Equal("A♥", fmt.Sprintf("%u", aceOfHearts))
+		assert.Equal("T♦", fmt.Sprintf("%u", tenOfDiamonds))
+	})
+	t.Run("width", func(t *testing.T) {
+		assert.Equal("  A♥", fmt.Sprintf("%4u", aceOfHearts))
+		assert.Equal("Ah  |", fmt.Sprintf("%-4v|", aceOfHearts))
+	})
+	t.Run("unknown verb", func(t *testing.T) {
+		assert.Equal("%!x(cards.Card=Ah)", fmt.Sprintf("%x", aceOfHearts))
+	})
+}
+
+func Test_Rank_Format(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal("Q", Queen.String())
+	assert.Equal("Queen", fmt.Sprintf("%l", Queen))
+	assert.Equal("12", fmt.Sprintf("%d", Queen))
+	assert.Equal("Little Joker", fmt.Sprintf("%l", LittleJoker))
+}
+
+func Test_SuitName_Format(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal("s", Spades.String())
+	assert.Equal("Spades", fmt.Sprintf("%l", Spades))
+	assert.Equal("♠", fmt.Sprintf("%u", Spades))
+}
+
+func Test_SuitColor_Format(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal("r", Red.String())
+	assert.Equal("Black", fmt.Sprintf("%l", Black))
+}
+
+func TestParseCard(t *testing.T) {
+	assert := assert.New(t)
+	t.Run("valid", func(t *testing.T) {
+		valid := map[string]struct {
+			rank Rank
+			suit SuitName
+		}{
+			"As":  {Ace, Spades},
+			"10h": {Ten, Hearts},
+			"Th":  {Ten, Hearts},
+			"Qd":  {Queen, Diamonds},
+			"2c":  {Two, Clubs},
+			"kS":  {King, Spades},
+			"jk":  {LittleJoker, Joker},
+			"JK":  {BigJoker, Joker},
+		}
+		for notation, expected := range valid {
+			card, err := ParseCard(notation)
+			if assert.NoError(err, notation) {
+				assert.Equal(expected.rank, card.Rank(), notation)
+				assert.Equal(expected.suit, card.Suit().Name(), notation)
+			}
+		}
+	})
+	t.Run("invalid", func(t *testing.T) {
+		for _, notation := range []string{"", "A", "1h", "Ax", "11s", "Jk", "jkh"} {
+			_, err := ParseCard(notation)
+			assert.IsType(&InvalidNotation{}, err, notation)
+		}
+	})
+	t.Run("round trip", func(t *testing.T) {
+		deck := NewStandardDeck(true)
+		for _, expected := range deck.cards {
+			card, err := ParseCard(expected.String())
+			if assert.NoError(err) {
+				assert.Equal(expected, card)
+			}
+		}
+	})
+}
+
+func TestParseCards(t *testing.T) {
+	assert := assert.New(t)
+	t.Run("valid", func(t *testing.T) {
+		cards, err := ParseCards("As Kd, 10h\tJK")
+		if assert.NoError(err) && assert.Len(cards, 4) {
+			assert.Equal("As Kd Th JK", fmt.Sprintf("%v %v %v %v", cards[0], cards[1], cards[2], cards[3]))
+		}
+	})
+	t.Run("invalid", func(t *testing.T) {
+		_, err := ParseCards("As Zz Kd 1h")
+		if assert.IsType(&InvalidNotation{}, err) {
+			assert.Equal([]string{"Zz", "1h"}, err.(*InvalidNotation).notations)
+		}
+	})
+}
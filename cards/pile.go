@@ -0,0 +1,260 @@
+package cards
+
+import (
+	"math/rand"
+)
+
+//Pile is an ordered pile of cards with a maximum size.
+//
+//Index 0 is the top of the pile.
+//Deck and Hand embed a Pile and games can embed one to define their own piles
+//e.g. discard piles, foundations, stock, waste or tableau columns.
+type Pile struct {
+	cards   []Card
+	maxSize int
+	rand    *rand.Rand
+}
+
+var _ CardPile = (*Pile)(nil)
+
+//NewPile returns a Pile allowing maxSize cards holding the given cards from top to bottom.
+//
+//Errors if there are more cards than maxSize.
+func NewPile(maxSize int, cards ...Card) (Pile, error) {
+	if len(cards) > maxSize {
+		return Pile{}, &NotEnough{requested: len(cards), available: maxSize}
+	}
+	return Pile{cards: append([]Card{}, cards...), maxSize: maxSize}, nil
+}
+
+//SetSource sets the source of randomness used to pick or place random cards.
+//
+//A Deck also shuffles with its source and seeds the sources of the hands it deals from it,
+//so decks using sources with the same seed shuffle and deal in the same order.
+//Without a source the pile uses its own source seeded from the time.
+func (p *Pile) SetSource(src rand.Source) {
+	p.rand = rand.New(src)
+}
+
+func (p *Pile) random() *rand.Rand {
+	if p.rand == nil {
+		p.rand = newRand()
+	}
+	return p.rand
+}
+
+//Cards returns a copy of the cards in the pile from top to bottom.
+func (p *Pile) Cards() []Card {
+	return append([]Card{}, p.cards...)
+}
+
+//CardCount returns the number of cards in the pile.
+func (p *Pile) CardCount() int {
+	return len(p.cards)
+}
+
+//MaxSize returns the maximum number of cards allowed in the pile.
+func (p *Pile) MaxSize() int {
+	return p.maxSize
+}
+
+//HasCard returns true if the pile has a matching card.
+func (p *Pile) HasCard(card *Card) bool {
+	for _, cardInPile := range p.cards {
+		if cardInPile.Matches(card) {
+			return true
+		}
+	}
+	return false
+}
+
+//Peek returns the cards at the given indices but does not remove them from the pile.
+func (p *Pile) Peek(indices []int) ([]Card, error) {
+	if invalid := outOfRange(indices, len(p.cards)); len(invalid) > 0 {
+		return nil, &OutOfRange{indices: invalid}
+	}
+	peeked := make([]Card, len(indices))
+	for i, index := range indices {
+		peeked[i] = p.cards[index]
+	}
+	return peeked, nil
+}
+
+//PeekTop returns the top card without removing it from the pile.
+func (p *Pile) PeekTop() (Card, error) {
+	if len(p.cards) < 1 {
+		return Card{}, &NotEnough{requested: 1, available: 0}
+	}
+	return p.cards[0], nil
+}
+
+//PeekBottom returns the bottom card without removing it from the pile.
+func (p *Pile) PeekBottom() (Card, error) {
+	if len(p.cards) < 1 {
+		return Card{}, &NotEnough{requested: 1, available: 0}
+	}
+	return p.cards[len(p.cards)-1], nil
+}
+
+//Pick returns the cards at the given indices and removes them from the pile.
+//
+//The indices refer to the state of the pile before any cards are removed.
+//Errors if indices are repeated.
+func (p *Pile) Pick(indices []int) ([]Card, error) {
+	if invalid := outOfRange(indices, len(p.cards)); len(invalid) > 0 {
+		return nil, &OutOfRange{indices: invalid}
+	}
+	if repeated := repeated(indices); len(repeated) > 0 {
+		return nil, &RepeatedIndex{indices: repeated}
+	}
+	picked := make([]Card, len(indices))
+	removed := make([]bool, len(p.cards))
+	for i, index := range indices {
+		picked[i] = p.cards[index]
+		removed[index] = true
+	}
+	kept := make([]Card, 0, len(p.cards)-len(indices))
+	for i, card := range p.cards {
+		if !removed[i] {
+			kept = append(kept, card)
+		}
+	}
+	p.cards = kept
+	return picked, nil
+}
+
+//PickTop returns the card on top removing it from the pile.
+func (p *Pile) PickTop() (Card, error) {
+	if len(p.cards) < 1 {
+		return Card{}, &NotEnough{requested: 1, available: 0}
+	}
+	card := p.cards[0]
+	p.cards = p.cards[1:]
+	return card, nil
+}
+
+//PickBottom returns the card at the bottom removing it from the pile.
+func (p *Pile) PickBottom() (Card, error) {
+	if len(p.cards) < 1 {
+		return Card{}, &NotEnough{requested: 1, available: 0}
+	}
+	card := p.cards[len(p.cards)-1]
+	p.cards = p.cards[:len(p.cards)-1]
+	return card, nil
+}
+
+//PickRandom returns and removes a random card from the pile.
+func (p *Pile) PickRandom() (Card, error) {
+	if len(p.cards) < 1 {
+		return Card{}, &NotEnough{requested: 1, available: 0}
+	}
+	card, err := p.Pick([]int{p.random().Intn(len(p.cards))})
+	if err != nil {
+		return Card{}, err
+	}
+	return card[0], nil
+}
+
+//Place inserts cards into the pile at the given indices.
+//
+//The indices refer to the state of the pile after all cards are inserted.
+//
+//Place errors if placing cards would exceed the max size,
+//an index would be beyond the end of the new pile,
+//indices are repeated, or inputs are different sizes.
+func (p *Pile) Place(cards []Card, indices []int) error {
+	if len(cards) != len(indices) {
+		return &MismatchedInputs{inputs: []string{"cards", "indices"}}
+	}
+	if p.maxSize < len(p.cards)+len(cards) {
+		return &NotEnough{requested: len(cards), available: p.maxSize - len(p.cards)}
+	}
+	if invalid := outOfRange(indices, len(p.cards)+len(cards)); len(invalid) > 0 {
+		return &OutOfRange{indices: invalid}
+	}
+	if repeated := repeated(indices); len(repeated) > 0 {
+		return &RepeatedIndex{indices: repeated}
+	}
+	newOrder := make([]Card, len(cards)+len(p.cards))
+	placed := make([]bool, len(newOrder))
+	for i, card := range cards {
+		newOrder[indices[i]] = card
+		placed[indices[i]] = true
+	}
+	var insertIndex int
+	for i := range newOrder {
+		if !placed[i] {
+			newOrder[i] = p.cards[insertIndex]
+			insertIndex++
+		}
+	}
+	p.cards = newOrder
+	return nil
+}
+
+//PlaceTop places a card at the top of the pile.
+func (p *Pile) PlaceTop(card Card) error {
+	if len(p.cards) >= p.maxSize {
+		return &NotEnough{requested: 1, available: 0}
+	}
+	p.cards = append([]Card{card}, p.cards...)
+	return nil
+}
+
+//PlaceBottom places a card at the bottom of the pile.
+func (p *Pile) PlaceBottom(card Card) error {
+	if len(p.cards) >= p.maxSize {
+		return &NotEnough{requested: 1, available: 0}
+	}
+	p.cards = append(p.cards[:len(p.cards):len(p.cards)], card)
+	return nil
+}
+
+//PlaceRandom places a card randomly into the pile.
+func (p *Pile) PlaceRandom(card Card) error {
+	if len(p.cards) >= p.maxSize {
+		return &NotEnough{requested: 1, available: 0}
+	}
+	spot := p.random().Intn(len(p.cards) + 1)
+	newOrder := make([]Card, len(p.cards)+1)
+	newOrder[spot] = card
+	copy(newOrder[:spot], p.cards[:spot])
+	copy(newOrder[spot+1:], p.cards[spot:])
+	p.cards = newOrder
+	return nil
+}
+
+//Cut moves the top n cards to the bottom of the pile.
+//
+//Errors if n is negative or more than the number of cards in the pile.
+func (p *Pile) Cut(n int) error {
+	if n < 0 || n > len(p.cards) {
+		return &OutOfRange{indices: []int{n}}
+	}
+	cut(p.cards, n)
+	return nil
+}
+
+//outOfRange returns the indices that are not in [0, length).
+func outOfRange(indices []int, length int) []int {
+	invalid := []int{}
+	for _, index := range indices {
+		if index < 0 || index >= length {
+			invalid = append(invalid, index)
+		}
+	}
+	return invalid
+}
+
+//repeated returns each index that appears more than once.
+func repeated(indices []int) []int {
+	repeated := []int{}
+	indexCount := map[int]int{}
+	for _, index := range indices {
+		indexCount[index]++
+		if indexCount[index] == 2 {
+			repeated = append(repeated, index)
+		}
+	}
+	return repeated
+}
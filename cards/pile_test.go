@@ -0,0 +1,96 @@
+package cards
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPile(t *testing.T) {
+	assert := assert.New(t)
+	deck := NewStandardDeck(false)
+	pile, err := NewPile(5, deck.cards[:3]...)
+	if assert.NoError(err) {
+		assert.Equal(deck.cards[:3], pile.Cards())
+		assert.Equal(5, pile.MaxSize())
+	}
+	_, err = NewPile(2, deck.cards[:3]...)
+	assert.IsType(&NotEnough{}, err)
+}
+
+func Test_Pile_Cards(t *testing.T) {
+	assert := assert.New(t)
+	deck := NewStandardDeck(false)
+	cards := deck.Cards()
+	cards[0] = Card{}
+	assert.False(deck.cards[0].IsEmpty())
+}
+
+func Test_Pile_Place(t *testing.T) {
+	assert := assert.New(t)
+	deck := NewStandardDeck(false)
+	pile, _ := NewPile(6, deck.cards[:3]...)
+	if assert.NoError(pile.Place(deck.cards[10:12], []int{4, 1})) {
+		expected := []Card{deck.cards[0], deck.cards[11], deck.cards[1], deck.cards[2], deck.cards[10]}
+		assert.Equal(expected, pile.cards)
+	}
+	assert.IsType(&OutOfRange{}, pile.Place(deck.cards[20:21], []int{-1}))
+}
+
+func Test_Pile_Pick(t *testing.T) {
+	assert := assert.New(t)
+	deck := NewStandardDeck(false)
+	pile, _ := NewPile(5, deck.cards[:5]...)
+	got, err := pile.Pick([]int{3, 0})
+	if assert.NoError(err) {
+		assert.Equal([]Card{deck.cards[3], deck.cards[0]}, got)
+		assert.Equal([]Card{deck.cards[1], deck.cards[2], deck.cards[4]}, pile.cards)
+	}
+	_, err = pile.Pick([]int{-1})
+	assert.IsType(&OutOfRange{}, err)
+	_, err = pile.Peek([]int{-1})
+	assert.IsType(&OutOfRange{}, err)
+}
+
+func Test_Pile_PickRandom(t *testing.T) {
+	assert := assert.New(t)
+	deck := NewStandardDeck(false)
+	pile, _ := NewPile(1, deck.cards[0])
+	card, err := pile.PickRandom()
+	if assert.NoError(err) {
+		assert.Equal(deck.cards[0], card)
+		assert.Equal(0, pile.CardCount())
+	}
+	_, err = pile.PickRandom()
+	assert.IsType(&NotEnough{}, err)
+}
+
+func Test_Pile_PlaceRandom(t *testing.T) {
+	assert := assert.New(t)
+	deck := NewStandardDeck(false)
+	pile, _ := NewPile(1)
+	if assert.NoError(pile.PlaceRandom(deck.cards[0])) {
+		assert.Equal([]Card{deck.cards[0]}, pile.cards)
+	}
+}
+
+//foundation is a pile defined by a game.
+type foundation struct {
+	Pile
+	suit Suit
+}
+
+func TestEmbeddedPile(t *testing.T) {
+	assert := assert.New(t)
+	deck := NewStandardDeck(false)
+	pile, _ := NewPile(13)
+	hearts := foundation{Pile: pile, suit: HeartsSuit}
+	var cardPile CardPile = &hearts
+	assert.NoError(cardPile.Place(deck.cards[39:40], []int{0}))
+	assert.NoError(hearts.PlaceTop(deck.cards[40]))
+	top, err := hearts.PeekTop()
+	if assert.NoError(err) {
+		assert.Equal(deck.cards[40], top)
+	}
+	assert.Equal(2, cardPile.CardCount())
+}
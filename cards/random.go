@@ -0,0 +1,17 @@
+package cards
+
+import (
+	"math/rand"
+	"sync/atomic"
+	"time"
+)
+
+var seedCounter int64
+
+//newRand returns a random source seeded from the time.
+//
+//A counter is mixed into the seed so sources created at the same moment differ.
+func newRand() *rand.Rand {
+	seed := time.Now().UnixNano() + atomic.AddInt64(&seedCounter, 1)*0x2545F4914F6CDD1D
+	return rand.New(rand.NewSource(seed))
+}
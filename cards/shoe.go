@@ -0,0 +1,133 @@
+package cards
+
+//defaultPenetration is the fraction of a shoe dealt before the cut card is reached.
+const defaultPenetration = 0.75
+
+//Shoe is a dealing shoe holding several standard decks, a cut card and a discard tray.
+//
+//The cards of the shoe come from its Deck so it can be shuffled, picked from,
+//peeked at and dealt from like any Deck.
+//Once the number of cards dealt reaches the cut card the shoe needs a reshuffle,
+//which returns the discard tray to the shoe.
+type Shoe struct {
+	Deck
+	decks   int
+	cutCard int
+	//reshuffled is the number of cards in the shoe after the last reshuffle.
+	reshuffled int
+	discards   Pile
+}
+
+var _ BasicDeck = (*Shoe)(nil)
+
+//NewShoe returns a Shoe holding the given number of standard decks without jokers.
+//
+//The cut card is placed so that 75% of the cards are dealt before a reshuffle.
+//Errors if there are fewer than one deck.
+func NewShoe(decks int) (Shoe, error) {
+	deck, err := NewDeck(WithCopies(decks))
+	if err != nil {
+		return Shoe{}, err
+	}
+	shoe := Shoe{
+		Deck:       deck,
+		decks:      decks,
+		reshuffled: deck.maxSize,
+		discards:   Pile{maxSize: deck.maxSize},
+	}
+	shoe.cutCard = int(defaultPenetration * float64(deck.maxSize))
+	return shoe, nil
+}
+
+//Decks returns the number of decks in the shoe.
+func (s *Shoe) Decks() int {
+	return s.decks
+}
+
+//SetPenetration places the cut card so the given fraction of the cards are dealt before a reshuffle.
+//
+//Errors if penetration is not greater than 0 and at most 1.
+func (s *Shoe) SetPenetration(penetration float64) error {
+	if penetration <= 0 || penetration > 1 {
+		return &InvalidOption{option: "penetration"}
+	}
+	s.cutCard = int(penetration * float64(s.maxSize))
+	return nil
+}
+
+//SetCutCard places the cut card so that position cards are dealt before a reshuffle.
+//
+//Errors if position is negative or more than the maxSize of the shoe.
+func (s *Shoe) SetCutCard(position int) error {
+	if position < 0 || position > s.maxSize {
+		return &OutOfRange{indices: []int{position}}
+	}
+	s.cutCard = position
+	return nil
+}
+
+//CutCard returns the number of cards dealt before a reshuffle.
+func (s *Shoe) CutCard() int {
+	return s.cutCard
+}
+
+//Dealt returns the number of cards that have left the shoe since it was last reshuffled.
+//
+//Cards dealt before the reshuffle and not yet discarded are not counted.
+func (s *Shoe) Dealt() int {
+	if dealt := s.reshuffled - len(s.cards); dealt > 0 {
+		return dealt
+	}
+	return 0
+}
+
+//DecksRemaining returns the number of decks left in the shoe as a fraction.
+func (s *Shoe) DecksRemaining() float64 {
+	return float64(len(s.cards)) * float64(s.decks) / float64(s.maxSize)
+}
+
+//NeedsReshuffle returns true once the cut card has been reached.
+func (s *Shoe) NeedsReshuffle() bool {
+	return s.Dealt() >= s.cutCard
+}
+
+//Burn moves n cards from the top of the shoe to the discard tray.
+//
+//Errors if n is negative or more than the cards left in the shoe.
+func (s *Shoe) Burn(n int) error {
+	if n < 0 {
+		return &OutOfRange{indices: []int{n}}
+	}
+	if n > len(s.cards) {
+		return &NotEnough{requested: n, available: len(s.cards)}
+	}
+	burned := s.cards[:n]
+	s.cards = s.cards[n:]
+	return s.Discard(burned...)
+}
+
+//Discard places cards on the discard tray.
+//
+//Errors if the tray would hold more cards than the shoe.
+func (s *Shoe) Discard(cards ...Card) error {
+	if s.discards.maxSize < len(s.discards.cards)+len(cards) {
+		return &NotEnough{requested: len(cards), available: s.discards.maxSize - len(s.discards.cards)}
+	}
+	s.discards.cards = append(s.discards.cards, cards...)
+	return nil
+}
+
+//DiscardCount returns the number of cards in the discard tray.
+func (s *Shoe) DiscardCount() int {
+	return len(s.discards.cards)
+}
+
+//Reshuffle returns the discard tray to the shoe and shuffles it.
+//
+//Cards that have been dealt but not discarded stay out of the shoe.
+func (s *Shoe) Reshuffle() {
+	s.cards = append(s.cards[:len(s.cards):len(s.cards)], s.discards.cards...)
+	s.discards.cards = nil
+	s.reshuffled = len(s.cards)
+	s.Shuffle()
+}
@@ -0,0 +1,91 @@
+package cards
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewShoe(t *testing.T) {
+	assert := assert.New(t)
+	shoe, err := NewShoe(6)
+	if assert.NoError(err) {
+		assert.Equal(6, shoe.Decks())
+		assert.Equal(312, shoe.MaxSize())
+		assert.Equal(312, shoe.CardCount())
+		assert.Equal(234, shoe.CutCard())
+		assert.Equal(6.0, shoe.DecksRemaining())
+		assert.False(shoe.NeedsReshuffle())
+	}
+	_, err = NewShoe(0)
+	assert.IsType(&InvalidOption{}, err)
+}
+
+func Test_Shoe_CutCard(t *testing.T) {
+	assert := assert.New(t)
+	shoe, _ := NewShoe(2)
+	assert.NoError(shoe.SetPenetration(0.5))
+	assert.Equal(52, shoe.CutCard())
+	assert.IsType(&InvalidOption{}, shoe.SetPenetration(0))
+	assert.IsType(&InvalidOption{}, shoe.SetPenetration(1.5))
+	assert.NoError(shoe.SetCutCard(10))
+	assert.Equal(10, shoe.CutCard())
+	assert.IsType(&OutOfRange{}, shoe.SetCutCard(105))
+	_, err := shoe.Deal(3, 3)
+	if assert.NoError(err) {
+		assert.Equal(9, shoe.Dealt())
+		assert.False(shoe.NeedsReshuffle())
+	}
+	_, err = shoe.PickTop()
+	if assert.NoError(err) {
+		assert.True(shoe.NeedsReshuffle())
+	}
+}
+
+func Test_Shoe_Reshuffle(t *testing.T) {
+	assert := assert.New(t)
+	shoe, _ := NewShoe(1)
+	shoe.SetSource(rand.NewSource(8))
+	shoe.Shuffle()
+	top, _ := shoe.PeekTop()
+	if assert.NoError(shoe.Burn(1)) {
+		assert.Equal(1, shoe.DiscardCount())
+		assert.False(shoe.HasCard(&top))
+	}
+	hands, err := shoe.Deal(2, 5)
+	if !assert.NoError(err) {
+		return
+	}
+	assert.NoError(shoe.Discard(hands[0].Cards()...))
+	assert.Equal(6, shoe.DiscardCount())
+	assert.Equal(11, shoe.Dealt())
+	shoe.Reshuffle()
+	assert.Equal(0, shoe.DiscardCount())
+	assert.Equal(47, shoe.CardCount())
+	assert.True(shoe.HasCard(&top))
+	held := hands[1].Cards()
+	assert.False(shoe.HasCard(&held[0]))
+	assert.Equal(0, shoe.Dealt())
+	assert.False(shoe.NeedsReshuffle())
+	assert.IsType(&NotEnough{}, shoe.Burn(48))
+	assert.IsType(&OutOfRange{}, shoe.Burn(-1))
+	assert.NoError(shoe.Burn(2))
+	assert.Equal(2, shoe.Dealt())
+}
+
+func Test_Shoe_DecksRemaining(t *testing.T) {
+	assert := assert.New(t)
+	shoe, _ := NewShoe(2)
+	_, err := shoe.Deal(4, 13)
+	if assert.NoError(err) {
+		assert.Equal(1.0, shoe.DecksRemaining())
+	}
+	deck, _ := NewDeck(WithRanks(Nine, Ten, Jack, Queen, King, Ace), WithCopies(2))
+	euchre := Shoe{Deck: deck, decks: 2, reshuffled: deck.maxSize}
+	_, err = euchre.Deal(1, 12)
+	if assert.NoError(err) {
+		assert.Equal(1.5, euchre.DecksRemaining())
+		assert.Equal(12, euchre.Dealt())
+	}
+}
@@ -1,8 +1,39 @@
 package cards
 
+import (
+	"math/rand"
+)
+
 //Shuffler is the interface that wraps the Shuffle method.
 //
 //Shuffle randomly sets the order of the underlying cards slice.
 type Shuffler interface {
 	Shuffle()
 }
+
+//CardShuffler is the interface that wraps the ShuffleCards method.
+//
+//ShuffleCards reorders the given cards slice in place.
+//Implementations that need randomness may draw it from r.
+type CardShuffler interface {
+	ShuffleCards(cards []Card, r *rand.Rand)
+}
+
+//ShufflerFor returns a Shuffler that shuffles the deck with the CardShuffler
+//and the deck's source of randomness, leaving the deck's own CardShuffler as it is.
+//
+//e.g. ShufflerFor(&deck, RiffleShuffler{Riffles: 7}) for anything that takes a Shuffler.
+func ShufflerFor(deck *Deck, s CardShuffler) Shuffler {
+	return deckShuffler{deck: deck, shuffler: s}
+}
+
+//deckShuffler adapts a CardShuffler to the Shuffler of a deck.
+type deckShuffler struct {
+	deck     *Deck
+	shuffler CardShuffler
+}
+
+//Shuffle implements Shuffler.
+func (s deckShuffler) Shuffle() {
+	s.shuffler.ShuffleCards(s.deck.cards, s.deck.random())
+}
@@ -0,0 +1,178 @@
+package cards
+
+import (
+	"math/rand"
+)
+
+//FisherYatesShuffler is a CardShuffler that puts the cards in a uniformly random order.
+//
+//It is the default shuffle of a Deck and runs in O(n).
+type FisherYatesShuffler struct{}
+
+//ShuffleCards implements CardShuffler.
+func (FisherYatesShuffler) ShuffleCards(cards []Card, r *rand.Rand) {
+	for i := len(cards) - 1; i > 0; i-- {
+		j := r.Intn(i + 1)
+		cards[i], cards[j] = cards[j], cards[i]
+	}
+}
+
+//RiffleShuffler is a CardShuffler modelling riffle shuffles with the Gilbert-Shannon-Reeds model.
+//
+//Each riffle cuts the cards into two packets with a binomially distributed size,
+//then drops cards from each packet with probability proportional to its size.
+//Seven riffles are generally enough to mix a 52 card deck.
+type RiffleShuffler struct {
+	//Riffles is the number of riffles, at least one riffle is always done.
+	Riffles int
+}
+
+//ShuffleCards implements CardShuffler.
+func (s RiffleShuffler) ShuffleCards(cards []Card, r *rand.Rand) {
+	if len(cards) < 2 {
+		return
+	}
+	riffled := make([]Card, len(cards))
+	for riffle := 0; riffle < s.Riffles || riffle == 0; riffle++ {
+		var cut int
+		for range cards {
+			cut += r.Intn(2)
+		}
+		left, right := cards[:cut], cards[cut:]
+		for i := range riffled {
+			if r.Intn(len(left)+len(right)) < len(left) {
+				riffled[i], left = left[0], left[1:]
+			} else {
+				riffled[i], right = right[0], right[1:]
+			}
+		}
+		copy(cards, riffled)
+	}
+}
+
+//OverhandShuffler is a CardShuffler modelling overhand shuffles.
+//
+//Each pass cuts between every pair of cards with probability 1/PacketSize
+//and reverses the order of the resulting packets, as when packets are
+//slid one at a time from one hand onto the other.
+type OverhandShuffler struct {
+	//Passes is the number of overhand shuffles, at least one pass is always done.
+	Passes int
+	//PacketSize is the average number of cards in a packet, 4 when less than 1.
+	PacketSize int
+}
+
+//ShuffleCards implements CardShuffler.
+func (s OverhandShuffler) ShuffleCards(cards []Card, r *rand.Rand) {
+	if len(cards) < 2 {
+		return
+	}
+	packetSize := s.PacketSize
+	if packetSize < 1 {
+		packetSize = 4
+	}
+	shuffled := make([]Card, len(cards))
+	for pass := 0; pass < s.Passes || pass == 0; pass++ {
+		end := len(shuffled)
+		start := 0
+		for i := 1; i <= len(cards); i++ {
+			if i == len(cards) || r.Intn(packetSize) == 0 {
+				size := i - start
+				copy(shuffled[end-size:end], cards[start:i])
+				end -= size
+				start = i
+			}
+		}
+		copy(cards, shuffled)
+	}
+}
+
+//PileShuffler is a CardShuffler modelling pile shuffles.
+//
+//The cards are dealt one at a time into Piles piles
+//and the piles are stacked back together in a random order.
+//A pile shuffle with a fixed pickup order is not random at all,
+//so it only mixes cards when combined with other shuffles.
+type PileShuffler struct {
+	//Piles is the number of piles dealt, fewer than 2 piles leaves the cards unchanged.
+	Piles int
+}
+
+//ShuffleCards implements CardShuffler.
+func (s PileShuffler) ShuffleCards(cards []Card, r *rand.Rand) {
+	if s.Piles < 2 || len(cards) < 2 {
+		return
+	}
+	piles := make([][]Card, s.Piles)
+	for i, card := range cards {
+		//Dealing places each card on top of its pile.
+		pile := i % s.Piles
+		piles[pile] = append([]Card{card}, piles[pile]...)
+	}
+	var i int
+	for _, pile := range r.Perm(s.Piles) {
+		i += copy(cards[i:], piles[pile])
+	}
+}
+
+//FaroShuffler is a CardShuffler doing a perfect faro shuffle.
+//
+//The cards are split exactly in half and perfectly interleaved.
+//An out shuffle keeps the top card on top, an in shuffle moves it to second.
+//Eight out shuffles return a 52 card deck to its original order.
+//Faro shuffles use no randomness.
+type FaroShuffler struct {
+	//Out selects an out shuffle instead of an in shuffle.
+	Out bool
+}
+
+//ShuffleCards implements CardShuffler.
+func (s FaroShuffler) ShuffleCards(cards []Card, _ *rand.Rand) {
+	if len(cards) < 2 {
+		return
+	}
+	half := len(cards) / 2
+	if s.Out {
+		half = (len(cards) + 1) / 2
+	}
+	top := append([]Card{}, cards[:half]...)
+	bottom := append([]Card{}, cards[half:]...)
+	first, second := top, bottom
+	if !s.Out {
+		first, second = bottom, top
+	}
+	for i := range cards {
+		if i%2 == 0 {
+			cards[i], first = first[0], first[1:]
+		} else {
+			cards[i], second = second[0], second[1:]
+		}
+	}
+}
+
+//CutShuffler is a CardShuffler that cuts the cards.
+//
+//The top Index cards are moved to the bottom.
+//An Index less than 1 or not less than the number of cards cuts at a random index.
+type CutShuffler struct {
+	Index int
+}
+
+//ShuffleCards implements CardShuffler.
+func (s CutShuffler) ShuffleCards(cards []Card, r *rand.Rand) {
+	if len(cards) < 2 {
+		return
+	}
+	index := s.Index
+	if index < 1 || index >= len(cards) {
+		index = 1 + r.Intn(len(cards)-1)
+	}
+	cut(cards, index)
+}
+
+//cut moves the top n cards to the bottom.
+func cut(cards []Card, n int) {
+	top := append([]Card{}, cards[:n]...)
+	copy(cards, cards[n:])
+	copy(cards[len(cards)-n:], top)
+}
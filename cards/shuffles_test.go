@@ -0,0 +1,138 @@
+package cards
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//risingSequences counts the maximal runs of consecutive indices in increasing position.
+func risingSequences(cards []Card) int {
+	positions := make([]int, len(cards))
+	for position, card := range cards {
+		positions[card.Index()] = position
+	}
+	sequences := 1
+	for i := 1; i < len(positions); i++ {
+		if positions[i] < positions[i-1] {
+			sequences++
+		}
+	}
+	return sequences
+}
+
+func TestCardShufflers(t *testing.T) {
+	assert := assert.New(t)
+	shufflers := map[string]CardShuffler{
+		"fisher yates": FisherYatesShuffler{},
+		"riffle":       RiffleShuffler{Riffles: 7},
+		"overhand":     OverhandShuffler{Passes: 3},
+		"pile":         PileShuffler{Piles: 6},
+		"faro":         FaroShuffler{},
+		"cut":          CutShuffler{},
+	}
+	for name, shuffler := range shufflers {
+		t.Run(name, func(t *testing.T) {
+			deck := NewStandardDeck(true)
+			deck.SetSource(rand.NewSource(3))
+			deck.SetShuffler(shuffler)
+			deck.Shuffle()
+			assert.Len(deck.cards, 54)
+			assert.Equal(IndexCount, deck.CardSet().Count())
+			assert.NotEqual(NewStandardDeck(true).cards, deck.cards)
+		})
+	}
+}
+
+func TestShufflerFor(t *testing.T) {
+	assert := assert.New(t)
+	a, b := NewStandardDeck(false), NewStandardDeck(false)
+	a.SetSource(rand.NewSource(5))
+	b.SetSource(rand.NewSource(5))
+	var shuffler Shuffler = ShufflerFor(&a, RiffleShuffler{Riffles: 1})
+	shuffler.Shuffle()
+	RiffleShuffler{Riffles: 1}.ShuffleCards(b.cards, b.random())
+	assert.Equal(b.cards, a.cards)
+	assert.LessOrEqual(risingSequences(a.cards), 2)
+	assert.Nil(a.shuffler)
+}
+
+func TestRiffleShuffler(t *testing.T) {
+	assert := assert.New(t)
+	r := rand.New(rand.NewSource(4))
+	for i := 0; i < 100; i++ {
+		deck := NewStandardDeck(false)
+		RiffleShuffler{Riffles: 1}.ShuffleCards(deck.cards, r)
+		assert.LessOrEqual(risingSequences(deck.cards), 2)
+	}
+	deck := NewStandardDeck(false)
+	RiffleShuffler{Riffles: 3}.ShuffleCards(deck.cards, r)
+	assert.LessOrEqual(risingSequences(deck.cards), 8)
+}
+
+func TestOverhandShuffler(t *testing.T) {
+	assert := assert.New(t)
+	deck := NewStandardDeck(false)
+	OverhandShuffler{PacketSize: 1}.ShuffleCards(deck.cards, rand.New(rand.NewSource(5)))
+	//Packets of one card reverse the deck.
+	for i, card := range deck.cards {
+		assert.Equal(51-i, card.Index())
+	}
+}
+
+func TestPileShuffler(t *testing.T) {
+	assert := assert.New(t)
+	deck := NewStandardDeck(false)
+	PileShuffler{Piles: 4}.ShuffleCards(deck.cards, rand.New(rand.NewSource(6)))
+	//Each pile of 13 holds every fourth card in reverse order.
+	for pile := 0; pile < 4; pile++ {
+		cards := deck.cards[pile*13 : pile*13+13]
+		for i := 1; i < len(cards); i++ {
+			assert.Equal(cards[i-1].Index()-4, cards[i].Index())
+		}
+	}
+}
+
+func TestFaroShuffler(t *testing.T) {
+	assert := assert.New(t)
+	t.Run("out", func(t *testing.T) {
+		deck := NewStandardDeck(false)
+		FaroShuffler{Out: true}.ShuffleCards(deck.cards, nil)
+		assert.Equal(0, deck.cards[0].Index())
+		assert.Equal(26, deck.cards[1].Index())
+		assert.Equal(51, deck.cards[51].Index())
+		for i := 1; i < 8; i++ {
+			FaroShuffler{Out: true}.ShuffleCards(deck.cards, nil)
+		}
+		assert.Equal(NewStandardDeck(false).cards, deck.cards)
+	})
+	t.Run("in", func(t *testing.T) {
+		deck := NewStandardDeck(false)
+		FaroShuffler{}.ShuffleCards(deck.cards, nil)
+		assert.Equal(26, deck.cards[0].Index())
+		assert.Equal(0, deck.cards[1].Index())
+		assert.Equal(25, deck.cards[51].Index())
+	})
+	t.Run("odd", func(t *testing.T) {
+		deck := NewStandardDeck(false)
+		cards := deck.cards[:5]
+		FaroShuffler{Out: true}.ShuffleCards(cards, nil)
+		assert.Equal([]int{0, 3, 1, 4, 2}, []int{cards[0].Index(), cards[1].Index(), cards[2].Index(), cards[3].Index(), cards[4].Index()})
+	})
+}
+
+func Test_Deck_Cut(t *testing.T) {
+	assert := assert.New(t)
+	deck := NewStandardDeck(false)
+	if assert.NoError(deck.Cut(10)) {
+		assert.Equal(10, deck.cards[0].Index())
+		assert.Equal(9, deck.cards[51].Index())
+	}
+	assert.Error(deck.Cut(53))
+	assert.Error(deck.Cut(-1))
+	shuffled := NewStandardDeck(false)
+	shuffled.SetShuffler(CutShuffler{Index: 20})
+	shuffled.Shuffle()
+	assert.Equal(20, shuffled.cards[0].Index())
+}
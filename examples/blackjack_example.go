@@ -0,0 +1,34 @@
+package examples
+
+import (
+	"log"
+
+	"github.com/anthonyrouseau/games/blackjack"
+)
+
+//BlackjackExample runs a function with basic usage of the blackjack package.
+func BlackjackExample() {
+	rules := blackjack.DefaultRules()
+	rules.HitSoft17 = true
+	game, err := blackjack.NewGame(rules)
+	if err != nil {
+		panic(err)
+	}
+	if err := game.Deal(10, 25); err != nil {
+		panic(err)
+	}
+	if game.Phase() == blackjack.Offers {
+		game.Peek()
+	}
+	for game.Phase() == blackjack.PlayerTurns {
+		if err := game.Act(game.Advice()); err != nil {
+			panic(err)
+		}
+	}
+	log.Println(game.DealerCards(), game.Net(0), game.Net(1))
+	counter := blackjack.NewCounter(blackjack.HiLo, game.Shoe())
+	if err := counter.CountRound(game); err != nil {
+		panic(err)
+	}
+	log.Println(counter.RunningCount(), counter.TrueCount())
+}
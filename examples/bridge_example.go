@@ -0,0 +1,41 @@
+package examples
+
+import (
+	"log"
+
+	"github.com/anthonyrouseau/games/bridge"
+	"github.com/anthonyrouseau/games/tricks"
+)
+
+//BridgeExample runs a function with basic usage of the bridge package.
+func BridgeExample() {
+	board, err := bridge.DealBoard(1)
+	if err != nil {
+		panic(err)
+	}
+	auction := board.Auction()
+	for _, call := range []bridge.Call{bridge.Bid(1, bridge.Clubs), bridge.Pass, bridge.Bid(3, bridge.NoTrump), bridge.Pass, bridge.Pass, bridge.Pass} {
+		if err := auction.Call(call); err != nil {
+			panic(err)
+		}
+	}
+	contract, _ := auction.Contract()
+	game, err := board.Play(contract)
+	if err != nil {
+		panic(err)
+	}
+	for game.Phase() == tricks.Playing {
+		player := game.Turn()
+		if err := game.Play(player, game.Legal(player)[0]); err != nil {
+			panic(err)
+		}
+	}
+	taken := game.TricksTaken(int(contract.Declarer)) + game.TricksTaken(int(contract.Dummy()))
+	score, err := board.Score(contract, taken)
+	if err != nil {
+		panic(err)
+	}
+	log.Println(contract, taken, score)
+	log.Println(board.PBN(), board.LIN())
+	log.Println(board.DoubleDummy()[bridge.NoTrump][bridge.South])
+}
@@ -15,8 +15,12 @@ func CardsExample() {
 		panic(err)
 	}
 	for _, hand := range hands {
-		for _, card := range hand.cards {
-			log.Println(card)
+		for i := 0; i < hand.CardCount(); i++ {
+			card, err := hand.Peek([]int{i})
+			if err != nil {
+				panic(err)
+			}
+			log.Println(card[0])
 		}
 	}
 
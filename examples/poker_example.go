@@ -0,0 +1,40 @@
+package examples
+
+import (
+	"log"
+
+	"github.com/anthonyrouseau/games/cards"
+	"github.com/anthonyrouseau/games/poker"
+)
+
+//PokerExample runs a function with basic usage of the poker package.
+func PokerExample() {
+	deck := cards.NewStandardDeck(false)
+	deck.Shuffle()
+	hands, err := deck.Deal(2, 7)
+	if err != nil {
+		panic(err)
+	}
+	for _, hand := range hands {
+		result, err := poker.EvaluateHand(hand)
+		if err != nil {
+			panic(err)
+		}
+		log.Println(result.Category, result.Best)
+	}
+	kings, err := poker.ParseRange("KK")
+	if err != nil {
+		panic(err)
+	}
+	aces, err := poker.ParseRange("AhAs")
+	if err != nil {
+		panic(err)
+	}
+	equities, err := poker.CalculateEquity([]poker.Range{aces, kings}, poker.WithTrials(10000))
+	if err != nil {
+		panic(err)
+	}
+	for _, equity := range equities {
+		log.Println(equity.Win, equity.Tie, equity.Loss)
+	}
+}
@@ -0,0 +1,31 @@
+package examples
+
+import (
+	"log"
+
+	"github.com/anthonyrouseau/games/cards"
+	"github.com/anthonyrouseau/games/rummy"
+)
+
+//RummyExample runs a function with basic usage of the rummy package.
+func RummyExample() {
+	deck := cards.NewStandardDeck(false)
+	deck.Shuffle()
+	hands, err := deck.Deal(2, 10)
+	if err != nil {
+		panic(err)
+	}
+	rules := rummy.DefaultRules()
+	arrangement, err := rummy.Arrange(hands[0], rules)
+	if err != nil {
+		panic(err)
+	}
+	log.Println(arrangement.Melds, arrangement.Deadwood, arrangement.Points)
+	if arrangement.Points <= rules.KnockLimit {
+		result, err := rummy.Knock(hands[0], hands[1], rules)
+		if err != nil {
+			panic(err)
+		}
+		log.Println(result.Defender.Layoffs, result.KnockerWins, result.Points)
+	}
+}
@@ -0,0 +1,36 @@
+package examples
+
+import (
+	"log"
+
+	"github.com/anthonyrouseau/games/solitaire"
+)
+
+//SolitaireExample runs a function with basic usage of the solitaire package.
+func SolitaireExample() {
+	game, err := solitaire.NewKlondike(3)
+	if err != nil {
+		panic(err)
+	}
+	log.Println(game.Moves())
+	freecell, err := solitaire.NewFreeCell(1)
+	if err != nil {
+		panic(err)
+	}
+	log.Println(freecell.AutoPlay())
+	moves, err := solitaire.Solve(game, 0)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	for i, move := range moves {
+		if err := game.Move(move); err != nil {
+			panic(err)
+		}
+		if i == len(moves)-1 {
+			game.Undo()
+			game.Move(move)
+		}
+	}
+	log.Println(game.Won(), game.MoveCount())
+}
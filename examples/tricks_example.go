@@ -0,0 +1,33 @@
+package examples
+
+import (
+	"log"
+
+	"github.com/anthonyrouseau/games/cards"
+	"github.com/anthonyrouseau/games/tricks"
+)
+
+//TricksExample runs a function with basic usage of the tricks package.
+func TricksExample() {
+	deck := cards.NewStandardDeck(false)
+	deck.Shuffle()
+	hands, err := deck.Deal(4, 13)
+	if err != nil {
+		panic(err)
+	}
+	game, err := tricks.NewHearts(tricks.DefaultHeartsRules(), hands)
+	if err != nil {
+		panic(err)
+	}
+	for game.Phase() == tricks.Playing {
+		player := game.Turn()
+		if err := game.Play(player, game.Legal(player)[0]); err != nil {
+			panic(err)
+		}
+	}
+	scoreboard := tricks.NewScoreboard(game.Teams())
+	if err := scoreboard.Record(game); err != nil {
+		panic(err)
+	}
+	log.Println(game.Tricks()[0].Cards(), game.Scores(), scoreboard.Points(0))
+}
@@ -0,0 +1,409 @@
+package poker
+
+import (
+	"math/rand"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/anthonyrouseau/games/cards"
+)
+
+const (
+	defaultTrials     = 100000
+	defaultExactLimit = 1000000
+	//maxDealAttempts is how many times a Monte Carlo trial tries to deal the ranges without a conflict.
+	maxDealAttempts = 100
+)
+
+//EquityOption configures the deal used by CalculateEquity.
+type EquityOption func(*equityConfig)
+
+type equityConfig struct {
+	board   []cards.Card
+	dead    []cards.Card
+	trials  int
+	exact   int
+	seed    int64
+	seeded  bool
+	workers int
+}
+
+//WithBoard sets the community cards already dealt, 0 to 5 cards.
+func WithBoard(board ...cards.Card) EquityOption {
+	return func(c *equityConfig) {
+		c.board = board
+	}
+}
+
+//WithDead sets cards removed from the deck that no player holds e.g. burnt or folded cards.
+func WithDead(dead ...cards.Card) EquityOption {
+	return func(c *equityConfig) {
+		c.dead = dead
+	}
+}
+
+//WithTrials sets the number of deals run by a Monte Carlo simulation, 100000 by default.
+//
+//CalculateEquity errors if trials is less than 1.
+func WithTrials(trials int) EquityOption {
+	return func(c *equityConfig) {
+		c.trials = trials
+	}
+}
+
+//WithExactLimit sets the largest number of deals enumerated exactly, 1000000 by default.
+//
+//Larger calculations are simulated.
+func WithExactLimit(deals int) EquityOption {
+	return func(c *equityConfig) {
+		c.exact = deals
+	}
+}
+
+//WithSeed sets the seed of a Monte Carlo simulation so it can be repeated.
+//
+//Simulations with the same seed, trials and workers give the same results.
+func WithSeed(seed int64) EquityOption {
+	return func(c *equityConfig) {
+		c.seed = seed
+		c.seeded = true
+	}
+}
+
+//WithWorkers sets the number of goroutines sharing the work, the number of CPUs by default.
+func WithWorkers(workers int) EquityOption {
+	return func(c *equityConfig) {
+		c.workers = workers
+	}
+}
+
+//Equity is how often a player wins, ties and loses over every deal of the remaining cards.
+type Equity struct {
+	Win, Tie, Loss float64
+	//Share is the average fraction of the pot won with tied pots split equally.
+	Share float64
+	//Deals is the number of deals evaluated.
+	Deals int
+	//Exact is true when every deal was enumerated rather than simulated.
+	Exact bool
+}
+
+//CalculateEquity returns the equity of each player holding a hand from their range
+//once the board is completed from the cards remaining in the deck.
+//
+//Deals are enumerated exactly when there are few enough, otherwise they are simulated.
+//Errors if the board has more than 5 cards, board or dead cards are jokers, invalid or repeated,
+//a range has no combos left once the board and dead cards are removed, trials is less than 1,
+//or the ranges can not be dealt together.
+func CalculateEquity(players []Range, opts ...EquityOption) ([]Equity, error) {
+	config := equityConfig{trials: defaultTrials, exact: defaultExactLimit, workers: runtime.NumCPU()}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	if config.workers < 1 {
+		config.workers = 1
+	}
+	if config.trials < 1 {
+		return nil, &InvalidOption{option: "trials"}
+	}
+	if !config.seeded {
+		config.seed = time.Now().UnixNano()
+	}
+	if len(config.board) > 5 {
+		return nil, &InvalidHandSize{size: len(config.board)}
+	}
+	removed := append(append([]cards.Card{}, config.board...), config.dead...)
+	if _, err := newSet(removed); err != nil {
+		return nil, err
+	}
+	deck := cards.NewStandardDeck(false)
+	if err := removeCards(&deck, removed); err != nil {
+		return nil, err
+	}
+	live := deck.CardSet()
+	ranges := make([][]cards.CardSet, len(players))
+	deals := 1.0
+	for i, player := range players {
+		for _, combo := range player.combos {
+			if combo.Difference(live) == 0 {
+				ranges[i] = append(ranges[i], combo)
+			}
+		}
+		if len(ranges[i]) == 0 {
+			return nil, &EmptyRange{player: i}
+		}
+		deals *= float64(len(ranges[i]))
+	}
+	calculation := equityCalculation{
+		ranges:  ranges,
+		board:   cards.NewCardSet(config.board...),
+		live:    live,
+		need:    5 - len(config.board),
+		workers: config.workers,
+	}
+	deals *= binomial(live.Count()-2*len(players), calculation.need)
+	var total tally
+	exact := deals <= float64(config.exact)
+	if exact {
+		total = calculation.enumerate()
+	} else {
+		total = calculation.simulate(config.trials, config.seed)
+	}
+	if total.deals == 0 {
+		return nil, &ImpossibleDeal{}
+	}
+	equities := make([]Equity, len(players))
+	for i := range equities {
+		deals := float64(total.deals)
+		equities[i] = Equity{
+			Win:   float64(total.wins[i]) / deals,
+			Tie:   float64(total.ties[i]) / deals,
+			Loss:  float64(total.deals-total.wins[i]-total.ties[i]) / deals,
+			Share: total.shares[i] / deals,
+			Deals: total.deals,
+			Exact: exact,
+		}
+	}
+	return equities, nil
+}
+
+//removeCards picks the cards out of the deck.
+func removeCards(deck *cards.Deck, cs []cards.Card) error {
+	remove := cards.NewCardSet(cs...)
+	for _, card := range cs {
+		if !deck.HasCard(&card) {
+			return &RepeatedCards{cards: []cards.Card{card}}
+		}
+	}
+	indices := []int{}
+	for i, card := range deck.Cards() {
+		if remove.Contains(card) {
+			indices = append(indices, i)
+		}
+	}
+	_, err := deck.Pick(indices)
+	return err
+}
+
+//binomial returns the number of ways to choose k of n things.
+func binomial(n, k int) float64 {
+	if k < 0 || k > n {
+		return 0
+	}
+	ways := 1.0
+	for i := 0; i < k; i++ {
+		ways = ways * float64(n-i) / float64(i+1)
+	}
+	return ways
+}
+
+//tally counts the results of deals for each player.
+type tally struct {
+	deals  int
+	wins   []int
+	ties   []int
+	shares []float64
+}
+
+func newTally(players int) tally {
+	return tally{wins: make([]int, players), ties: make([]int, players), shares: make([]float64, players)}
+}
+
+//add counts a deal where each player made the given value.
+func (t *tally) add(values []Value) {
+	t.deals++
+	var best Value
+	winners := 0
+	for _, value := range values {
+		switch {
+		case value > best:
+			best = value
+			winners = 1
+		case value == best:
+			winners++
+		}
+	}
+	for i, value := range values {
+		if value != best {
+			continue
+		}
+		if winners == 1 {
+			t.wins[i]++
+		} else {
+			t.ties[i]++
+		}
+		t.shares[i] += 1 / float64(winners)
+	}
+}
+
+func (t *tally) merge(other tally) {
+	t.deals += other.deals
+	for i := range t.wins {
+		t.wins[i] += other.wins[i]
+		t.ties[i] += other.ties[i]
+		t.shares[i] += other.shares[i]
+	}
+}
+
+//equityCalculation holds what is known about a deal.
+type equityCalculation struct {
+	ranges  [][]cards.CardSet
+	board   cards.CardSet
+	live    cards.CardSet
+	need    int
+	workers int
+}
+
+//equityTask is the deals of one combo for each player completed by boards starting with a card.
+type equityTask struct {
+	holes []cards.CardSet
+	first int
+}
+
+//enumerate counts every deal of the ranges and board.
+func (e equityCalculation) enumerate() tally {
+	tasks := make(chan equityTask)
+	go func() {
+		defer close(tasks)
+		e.forEachAssignment(func(holes []cards.CardSet) {
+			if e.need == 0 {
+				tasks <- equityTask{holes: holes}
+				return
+			}
+			remaining := e.live.Count() - 2*len(holes)
+			for first := 0; first <= remaining-e.need; first++ {
+				tasks <- equityTask{holes: holes, first: first}
+			}
+		})
+	}()
+	return e.run(func(t *tally) {
+		values := make([]Value, len(e.ranges))
+		for task := range tasks {
+			var used cards.CardSet
+			for _, hole := range task.holes {
+				used |= hole
+			}
+			remaining := e.live.Difference(used).Cards()
+			if e.need == 0 {
+				e.evaluate(t, task.holes, e.board, values)
+				continue
+			}
+			board := e.board
+			board.Add(remaining[task.first])
+			rest := remaining[task.first+1:]
+			forEachCombination(len(rest), e.need-1, func(indices []int) {
+				deal := board
+				for _, index := range indices {
+					deal.Add(rest[index])
+				}
+				e.evaluate(t, task.holes, deal, values)
+			})
+		}
+	})
+}
+
+//forEachAssignment calls f with every way of giving each player a combo from their range without sharing cards.
+func (e equityCalculation) forEachAssignment(f func(holes []cards.CardSet)) {
+	var assign func(player int, used cards.CardSet, holes []cards.CardSet)
+	assign = func(player int, used cards.CardSet, holes []cards.CardSet) {
+		if player == len(e.ranges) {
+			f(append([]cards.CardSet{}, holes...))
+			return
+		}
+		for _, combo := range e.ranges[player] {
+			if combo&used == 0 {
+				assign(player+1, used|combo, append(holes, combo))
+			}
+		}
+	}
+	assign(0, 0, make([]cards.CardSet, 0, len(e.ranges)))
+}
+
+//simulate counts random deals of the ranges and board split between the workers.
+func (e equityCalculation) simulate(trials int, seed int64) tally {
+	liveCards := e.live.Cards()
+	worker := make(chan int, e.workers)
+	for i := 0; i < e.workers; i++ {
+		worker <- i
+	}
+	close(worker)
+	return e.run(func(t *tally) {
+		index := <-worker
+		r := rand.New(rand.NewSource(seed + int64(index)))
+		values := make([]Value, len(e.ranges))
+		holes := make([]cards.CardSet, len(e.ranges))
+		share := trials / e.workers
+		if index < trials%e.workers {
+			share++
+		}
+		for trial := 0; trial < share; trial++ {
+			used, ok := e.dealRanges(r, holes)
+			if !ok {
+				continue
+			}
+			board := e.board
+			for dealt := 0; dealt < e.need; {
+				card := liveCards[r.Intn(len(liveCards))]
+				if used.Contains(card) {
+					continue
+				}
+				used.Add(card)
+				board.Add(card)
+				dealt++
+			}
+			e.evaluate(t, holes, board, values)
+		}
+	})
+}
+
+//dealRanges gives each player a random combo from their range without sharing cards.
+//
+//Conflicting deals are thrown away and dealt again so every valid deal is equally likely.
+func (e equityCalculation) dealRanges(r *rand.Rand, holes []cards.CardSet) (cards.CardSet, bool) {
+	for attempt := 0; attempt < maxDealAttempts; attempt++ {
+		var used cards.CardSet
+		ok := true
+		for i, combos := range e.ranges {
+			combo := combos[r.Intn(len(combos))]
+			if combo&used != 0 {
+				ok = false
+				break
+			}
+			used |= combo
+			holes[i] = combo
+		}
+		if ok {
+			return used, true
+		}
+	}
+	return 0, false
+}
+
+//evaluate counts the deal of the hole cards and the full board.
+func (e equityCalculation) evaluate(t *tally, holes []cards.CardSet, board cards.CardSet, values []Value) {
+	for i, hole := range holes {
+		values[i] = EvaluateSet(board | hole)
+	}
+	t.add(values)
+}
+
+//run calls work in each worker goroutine and merges their tallies.
+func (e equityCalculation) run(work func(t *tally)) tally {
+	tallies := make([]tally, e.workers)
+	var wg sync.WaitGroup
+	for i := range tallies {
+		tallies[i] = newTally(len(e.ranges))
+		wg.Add(1)
+		go func(t *tally) {
+			defer wg.Done()
+			work(t)
+		}(&tallies[i])
+	}
+	wg.Wait()
+	total := newTally(len(e.ranges))
+	for _, t := range tallies {
+		total.merge(t)
+	}
+	return total
+}
@@ -0,0 +1,80 @@
+package poker
+
+import (
+	"testing"
+
+	"github.com/anthonyrouseau/games/cards"
+	"github.com/stretchr/testify/assert"
+)
+
+func hole(notation string) Range {
+	cs := mustParse(notation)
+	r, err := HoleCards(cs[0], cs[1])
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+func TestCalculateEquityExact(t *testing.T) {
+	assert := assert.New(t)
+	equities, err := CalculateEquity([]Range{hole("As Ad"), hole("Qh Jh")}, WithBoard(mustParse("Ah Kd 7c 2s")...))
+	assert.NoError(err)
+	assert.True(equities[0].Exact)
+	assert.Equal(44, equities[0].Deals)
+	assert.InDelta(40.0/44, equities[0].Win, 1e-9)
+	assert.InDelta(4.0/44, equities[1].Win, 1e-9)
+	assert.InDelta(40.0/44, equities[1].Loss, 1e-9)
+	assert.InDelta(4.0/44, equities[1].Share, 1e-9)
+	equities, err = CalculateEquity([]Range{hole("2c 3d"), hole("4c 5d")}, WithBoard(mustParse("Ah Kh Qh Jh Th")...))
+	assert.NoError(err)
+	for _, equity := range equities {
+		assert.Equal(Equity{Tie: 1, Share: 0.5, Deals: 1, Exact: true}, equity)
+	}
+}
+
+func TestCalculateEquityRanges(t *testing.T) {
+	assert := assert.New(t)
+	kings, _ := ParseRange("KK")
+	equities, err := CalculateEquity([]Range{hole("As Ad"), kings},
+		WithBoard(mustParse("Kh 7c 2d 3s")...), WithDead(mustParse("Ks")...))
+	assert.NoError(err)
+	assert.True(equities[0].Exact)
+	//Only Kc Kd is left in the range and the two Aces left are the only rivers it loses to.
+	assert.Equal(43, equities[0].Deals)
+	assert.InDelta(41.0/43, equities[1].Win, 1e-9)
+}
+
+func TestCalculateEquitySimulated(t *testing.T) {
+	assert := assert.New(t)
+	players := []Range{hole("As Ah"), hole("Kc Kd")}
+	exact, err := CalculateEquity(players, WithExactLimit(2000000))
+	assert.NoError(err)
+	assert.True(exact[0].Exact)
+	simulated, err := CalculateEquity(players, WithSeed(7), WithWorkers(3), WithTrials(60000))
+	assert.NoError(err)
+	assert.False(simulated[0].Exact)
+	assert.Equal(60000, simulated[0].Deals)
+	for i := range players {
+		assert.InDelta(exact[i].Share, simulated[i].Share, 0.01)
+	}
+	again, _ := CalculateEquity(players, WithSeed(7), WithWorkers(3), WithTrials(60000))
+	assert.Equal(simulated, again)
+}
+
+func TestCalculateEquityErrors(t *testing.T) {
+	assert := assert.New(t)
+	aces, _ := ParseRange("AA")
+	_, err := CalculateEquity([]Range{aces, hole("Kc Kd")}, WithBoard(mustParse("Ah Ad Ac")...))
+	assert.IsType(&EmptyRange{}, err)
+	_, err = CalculateEquity([]Range{aces, aces}, WithDead(mustParse("Ah")...))
+	assert.IsType(&ImpossibleDeal{}, err)
+	_, err = CalculateEquity([]Range{aces}, WithBoard(mustParse("2c 3c 4c 5c 6c 7c")...))
+	assert.IsType(&InvalidHandSize{}, err)
+	_, err = CalculateEquity([]Range{aces}, WithBoard(mustParse("2c 3c 4c")...), WithDead(mustParse("2c")...))
+	assert.IsType(&RepeatedCards{}, err)
+	_, err = CalculateEquity([]Range{aces}, WithDead(cards.Card{}))
+	assert.IsType(&InvalidCards{}, err)
+	_, err = CalculateEquity([]Range{aces, hole("Kc Kd")}, WithTrials(0), WithExactLimit(0))
+	assert.IsType(&InvalidOption{}, err)
+}
@@ -0,0 +1,100 @@
+package poker
+
+import (
+	"fmt"
+
+	"github.com/anthonyrouseau/games/cards"
+)
+
+//InvalidHandSize signals a number of cards that can not be evaluated.
+//
+//e.g. evaluating a hand of 4 cards.
+type InvalidHandSize struct {
+	size int
+}
+
+func (e *InvalidHandSize) Error() string {
+	return fmt.Sprintf("Hands of %d cards can not be evaluated.", e.size)
+}
+
+//InvalidCards signals cards that can not be part of a poker hand.
+//
+//e.g. evaluating a hand holding a joker without wild cards.
+type InvalidCards struct {
+	cards []cards.Card
+}
+
+func (e *InvalidCards) Error() string {
+	return fmt.Sprintf("Cards %v can not be evaluated.", e.cards)
+}
+
+//RepeatedCards signals duplicates of a card.
+//
+//e.g. evaluating a hand holding two Aces of Spades.
+type RepeatedCards struct {
+	cards []cards.Card
+}
+
+func (e *RepeatedCards) Error() string {
+	return fmt.Sprintf("Cards %v were repeated.", e.cards)
+}
+
+//InvalidLowRule signals a LowRule that does not exist.
+type InvalidLowRule struct {
+	rule LowRule
+}
+
+func (e *InvalidLowRule) Error() string {
+	return fmt.Sprintf("Low rule %d does not exist.", e.rule)
+}
+
+//InvalidRange signals hand ranges that could not be parsed.
+type InvalidRange struct {
+	notations []string
+}
+
+func (e *InvalidRange) Error() string {
+	return fmt.Sprintf("Ranges %q are not valid hand ranges.", e.notations)
+}
+
+//EmptyRange signals a player with no combos left in their range.
+//
+//e.g. a range of AA when three Aces are on the board.
+type EmptyRange struct {
+	player int
+}
+
+func (e *EmptyRange) Error() string {
+	return fmt.Sprintf("Player %d has no hands left in their range.", e.player)
+}
+
+//ImpossibleDeal signals ranges that can not be dealt together.
+//
+//e.g. two players holding AA when an Ace is dead.
+type ImpossibleDeal struct{}
+
+func (e *ImpossibleDeal) Error() string {
+	return "The ranges can not be dealt together from the cards remaining."
+}
+
+//MismatchedResults signals a different number of low results than high values.
+//
+//e.g. splitting a pot between 3 high hands and 2 low hands.
+type MismatchedResults struct {
+	highs, lows int
+}
+
+func (e *MismatchedResults) Error() string {
+	return fmt.Sprintf("There are %d low results for %d high hands.", e.lows, e.highs)
+}
+
+//InvalidOption signals an option that can not be used.
+//
+//e.g. simulating 0 trials.
+type InvalidOption struct {
+	option string
+}
+
+func (e *InvalidOption) Error() string {
+	return fmt.Sprintf("Option %s is not valid.", e.option)
+}
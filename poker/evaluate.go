@@ -0,0 +1,272 @@
+package poker
+
+import (
+	"math/bits"
+
+	"github.com/anthonyrouseau/games/cards"
+)
+
+//Ranks are held in 13 bit masks with a Two in bit 0 up to an Ace in bit 12.
+const (
+	rankCount = 13
+	aceIndex  = 12
+	fiveIndex = 3
+	wheelMask = 1<<aceIndex | 0xF
+)
+
+//straightHigh holds the index of the high card of the best straight in a mask plus one, 0 if there is none.
+var straightHigh [1 << rankCount]uint8
+
+//topFive holds the indices of the five highest ranks in a mask packed from the most significant nibble down.
+var topFive [1 << rankCount]uint32
+
+func init() {
+	for mask := range topFive {
+		var packed uint32
+		var found uint
+		for index := aceIndex; index >= 0 && found < 5; index-- {
+			if mask&(1<<uint(index)) != 0 {
+				packed |= uint32(index) << ((4 - found) * rankBits)
+				found++
+			}
+		}
+		topFive[mask] = packed
+		for high := aceIndex; high >= fiveIndex; high-- {
+			run := 0x1F << uint(high-4)
+			if high == fiveIndex {
+				run = wheelMask
+			}
+			if mask&run == run {
+				straightHigh[mask] = uint8(high + 1)
+				break
+			}
+		}
+	}
+}
+
+//top returns the indices of the n highest ranks in the mask packed into the lowest n nibbles.
+func top(mask uint32, n int) uint32 {
+	return topFive[mask] >> (uint(5-n) * rankBits)
+}
+
+//highest returns the index of the highest rank in the mask.
+func highest(mask uint32) uint32 {
+	return uint32(bits.Len32(mask) - 1)
+}
+
+//Result is the evaluation of a poker hand.
+type Result struct {
+	Category Category
+	Value    Value
+	//Best holds the five cards making the hand ordered from most to least significant.
+	//Wild cards are replaced by the cards they stand in for.
+	Best []cards.Card
+	//Substitutions holds the card each wild card in the hand stood in for.
+	Substitutions []Substitution
+}
+
+//Evaluate returns the best five card poker hand from 5 to 7 cards.
+//
+//Aces are high except in the wheel straight, A-2-3-4-5, where they are low.
+//Errors if there are not 5 to 7 cards, any card is a joker or invalid,
+//or a card is repeated.
+func Evaluate(cs []cards.Card) (Result, error) {
+	set, err := newHandSet(cs)
+	if err != nil {
+		return Result{}, err
+	}
+	value := EvaluateSet(set)
+	return Result{
+		Category: value.Category(),
+		Value:    value,
+		Best:     bestCards(cs, value),
+	}, nil
+}
+
+//EvaluateHand returns the best five card poker hand from the cards in a hand.
+func EvaluateHand(h *cards.Hand) (Result, error) {
+	return Evaluate(h.Cards())
+}
+
+//newHandSet returns the set of 5 to 7 cards checking none are jokers, invalid or repeated.
+func newHandSet(cs []cards.Card) (cards.CardSet, error) {
+	if len(cs) < 5 || len(cs) > 7 {
+		return 0, &InvalidHandSize{size: len(cs)}
+	}
+	return newSet(cs)
+}
+
+//newSet returns the set of cards checking none are jokers, invalid or repeated.
+func newSet(cs []cards.Card) (cards.CardSet, error) {
+	var set cards.CardSet
+	invalid := []cards.Card{}
+	repeated := []cards.Card{}
+	for _, card := range cs {
+		switch {
+		case card.Index() < 0 || card.Index() >= 52:
+			invalid = append(invalid, card)
+		case set.Contains(card):
+			repeated = append(repeated, card)
+		default:
+			set.Add(card)
+		}
+	}
+	if len(invalid) > 0 {
+		return 0, &InvalidCards{cards: invalid}
+	}
+	if len(repeated) > 0 {
+		return 0, &RepeatedCards{cards: repeated}
+	}
+	return set, nil
+}
+
+//EvaluateSet returns the value of the best five card poker hand in a set of at least 5 cards.
+//
+//EvaluateSet does no validation and allocates nothing, jokers in the set are ignored.
+//It is meant for tight loops such as simulations.
+func EvaluateSet(s cards.CardSet) Value {
+	return evaluateMasks(suitMasks(s))
+}
+
+//suitMasks returns the rank masks of each suit in the set.
+//
+//Card indices run from Ace to King within each suit so the Ace is rotated to the top.
+func suitMasks(s cards.CardSet) [4]uint32 {
+	var masks [4]uint32
+	for i := range masks {
+		suit := uint32(uint64(s)>>(uint(i)*rankCount)) & (1<<rankCount - 1)
+		masks[i] = suit>>1 | (suit&1)<<aceIndex
+	}
+	return masks
+}
+
+func evaluateMasks(suits [4]uint32) Value {
+	a, b, c, d := suits[0], suits[1], suits[2], suits[3]
+	all := a | b | c | d
+	twos := a&b | a&c | a&d | b&c | b&d | c&d
+	threes := a&b&c | a&b&d | a&c&d | b&c&d
+	fours := a & b & c & d
+	var flush Value
+	for _, suit := range suits {
+		if bits.OnesCount32(suit) < 5 {
+			continue
+		}
+		if high := straightHigh[suit]; high != 0 {
+			if uint32(high-1) == aceIndex {
+				return newValue(RoyalFlush, aceIndex<<16)
+			}
+			if value := newValue(StraightFlush, uint32(high-1)<<16); value > flush {
+				flush = value
+			}
+		} else if value := newValue(Flush, topFive[suit]); value > flush {
+			flush = value
+		}
+	}
+	if flush.Category() == StraightFlush {
+		return flush
+	}
+	if fours != 0 {
+		quad := highest(fours)
+		return newValue(FourOfAKind, quad<<16|top(all&^(1<<quad), 1)<<12)
+	}
+	if threes != 0 {
+		trip := highest(threes)
+		if pairs := twos &^ (1 << trip); pairs != 0 {
+			return newValue(FullHouse, trip<<16|highest(pairs)<<12)
+		}
+	}
+	if flush != 0 {
+		return flush
+	}
+	if high := straightHigh[all]; high != 0 {
+		return newValue(Straight, uint32(high-1)<<16)
+	}
+	if threes != 0 {
+		trip := highest(threes)
+		return newValue(ThreeOfAKind, trip<<16|top(all&^(1<<trip), 2)<<8)
+	}
+	if bits.OnesCount32(twos) >= 2 {
+		high := highest(twos)
+		low := highest(twos &^ (1 << high))
+		return newValue(TwoPair, high<<16|low<<12|top(all&^(1<<high|1<<low), 1)<<8)
+	}
+	if twos != 0 {
+		pair := highest(twos)
+		return newValue(OnePair, pair<<16|top(all&^(1<<pair), 3)<<4)
+	}
+	return newValue(HighCard, topFive[all])
+}
+
+//pattern returns how many cards of each rank in a value make up a hand of the category.
+func pattern(category Category) []int {
+	switch category {
+	case OnePair:
+		return []int{2, 1, 1, 1}
+	case TwoPair:
+		return []int{2, 2, 1}
+	case ThreeOfAKind:
+		return []int{3, 1, 1}
+	case FullHouse:
+		return []int{3, 2}
+	case FourOfAKind:
+		return []int{4, 1}
+	case FiveOfAKind:
+		return []int{5}
+	}
+	return []int{1, 1, 1, 1, 1}
+}
+
+//valueRanks returns the rank indices of the cards making up a value with the count of each.
+func valueRanks(v Value) ([]int, []int) {
+	ranks := v.ranks()
+	counts := pattern(v.Category())
+	switch v.Category() {
+	case Straight, StraightFlush, RoyalFlush:
+		high := ranks[0]
+		for i := range ranks {
+			ranks[i] = (high - i + rankCount) % rankCount
+		}
+		if high == fiveIndex {
+			ranks[4] = aceIndex
+		}
+	}
+	return ranks[:len(counts)], counts
+}
+
+//bestCards returns the cards from cs making up the hand with value v.
+func bestCards(cs []cards.Card, v Value) []cards.Card {
+	ranks, counts := valueRanks(v)
+	flush := v.Category() == Flush || v.Category() == StraightFlush || v.Category() == RoyalFlush
+	var suit cards.Suit
+	if flush {
+		suitCounts := map[cards.Suit]int{}
+		for _, card := range cs {
+			suitCounts[card.Suit()]++
+			if suitCounts[card.Suit()] >= 5 {
+				suit = card.Suit()
+			}
+		}
+	}
+	best := make([]cards.Card, 0, 5)
+	used := make([]bool, len(cs))
+	for i, rank := range ranks {
+		need := counts[i]
+		for j, card := range cs {
+			if need == 0 {
+				break
+			}
+			if used[j] || rankIndex(card.Rank()) != rank || (flush && card.Suit() != suit) {
+				continue
+			}
+			used[j] = true
+			best = append(best, card)
+			need--
+		}
+	}
+	return best
+}
+
+//rankIndex returns the index of a rank with Aces high.
+func rankIndex(rank cards.Rank) int {
+	return (int(rank) + rankCount - 2) % rankCount
+}
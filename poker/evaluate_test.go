@@ -0,0 +1,146 @@
+package poker
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/anthonyrouseau/games/cards"
+	"github.com/stretchr/testify/assert"
+)
+
+func mustParse(notation string) []cards.Card {
+	cs, err := cards.ParseCards(notation)
+	if err != nil {
+		panic(err)
+	}
+	return cs
+}
+
+func evaluate(notation string) Result {
+	result, err := Evaluate(mustParse(notation))
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+func TestEvaluate(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		hand     string
+		category Category
+		best     string
+	}{
+		{"As Ks Qs Js Ts", RoyalFlush, "As Ks Qs Js Ts"},
+		{"9h 8h 7h 6h 5h 2c 2d", StraightFlush, "9h 8h 7h 6h 5h"},
+		{"5d 4d 3d 2d Ad Kd", StraightFlush, "5d 4d 3d 2d Ad"},
+		{"7c 7d 7h 7s Kd 2c", FourOfAKind, "7c 7d 7h 7s Kd"},
+		{"Qc Qd Qh 9s 9d 9c 2h", FullHouse, "Qc Qd Qh 9s 9d"},
+		{"Ah 9h 7h 4h 2h Kc Ks", Flush, "Ah 9h 7h 4h 2h"},
+		{"Th 9d 8c 7s 6h 5d", Straight, "Th 9d 8c 7s 6h"},
+		{"Ah 2d 3c 4s 5h Kd", Straight, "5h 4s 3c 2d Ah"},
+		{"Ac Kd Qh Js Th", Straight, "Ac Kd Qh Js Th"},
+		{"8c 8d 8h Kc 3d 2s", ThreeOfAKind, "8c 8d 8h Kc 3d"},
+		{"Jc Jd 4h 4s 9c 9d 2h", TwoPair, "Jc Jd 9c 9d 4h"},
+		{"Tc Td Ah 8s 4c 3d", OnePair, "Tc Td Ah 8s 4c"},
+		{"Ac Qd 9h 7s 4c 3d 2h", HighCard, "Ac Qd 9h 7s 4c"},
+	}
+	for _, test := range tests {
+		result := evaluate(test.hand)
+		assert.Equal(test.category, result.Category, test.hand)
+		assert.Equal(test.category, result.Value.Category(), test.hand)
+		assert.Equal(mustParse(test.best), result.Best, test.hand)
+	}
+}
+
+func TestEvaluateCompare(t *testing.T) {
+	assert := assert.New(t)
+	better := [][2]string{
+		{"6h 5h 4h 3h 2h", "5s 4s 3s 2s As"},
+		{"6c 5d 4h 3s 2c", "5c 4d 3h 2s Ac"},
+		{"Ac Kd Qh Js Th", "Kc Qd Jh Ts 9c"},
+		{"Ac Ad Kh Qs Jc", "Ac Ad Kh Qs Tc"},
+		{"Ac Ad 3h 3s 5c", "Ac Ad 3h 3s 4c"},
+		{"2c 2d 2h 3s 3c", "Ac Kc Qc Jc 9c"},
+		{"Ac Kc Qc Jc 8c", "Ad Kd Qd Jd 7d"},
+		{"Kc Kd Kh Ks 3c", "Qc Qd Qh Qs Ac"},
+	}
+	for _, hands := range better {
+		assert.Greater(uint32(evaluate(hands[0]).Value), uint32(evaluate(hands[1]).Value), hands)
+	}
+	assert.Equal(evaluate("Ac Kd Qh Js 9c").Value, evaluate("Ad Kh Qs Jc 9d").Value)
+	assert.Equal(evaluate("Ac Ad Kh Qs Jc 2d 3h").Value, evaluate("Ac Ad Kh Qs Jc").Value)
+}
+
+func TestEvaluateErrors(t *testing.T) {
+	assert := assert.New(t)
+	_, err := Evaluate(mustParse("As Ks Qs Js"))
+	assert.IsType(&InvalidHandSize{}, err)
+	_, err = Evaluate(mustParse("As Ks Qs Js Ts 9s 8s 7s"))
+	assert.IsType(&InvalidHandSize{}, err)
+	_, err = Evaluate(mustParse("As Ks Qs Js JK"))
+	assert.IsType(&InvalidCards{}, err)
+	_, err = Evaluate(mustParse("As Ks Qs Js As"))
+	assert.IsType(&RepeatedCards{}, err)
+}
+
+func TestEvaluateHand(t *testing.T) {
+	assert := assert.New(t)
+	deck := cards.NewStandardDeck(false)
+	hands, err := deck.Deal(1, 5)
+	if assert.NoError(err) {
+		result, err := EvaluateHand(hands[0])
+		if assert.NoError(err) {
+			assert.Equal(StraightFlush, result.Category)
+			assert.Equal("5c 4c 3c 2c Ac", fmt.Sprint(result.Best[0], result.Best[1], result.Best[2], result.Best[3], result.Best[4]))
+		}
+	}
+}
+
+func TestEvaluateAllFiveCardHands(t *testing.T) {
+	if testing.Short() {
+		t.Skip("enumerates every five card hand")
+	}
+	assert := assert.New(t)
+	counts := map[Category]int{}
+	var hand cards.CardSet
+	for a := 0; a < 52; a++ {
+		for b := a + 1; b < 52; b++ {
+			for c := b + 1; c < 52; c++ {
+				for d := c + 1; d < 52; d++ {
+					for e := d + 1; e < 52; e++ {
+						hand = 1<<uint(a) | 1<<uint(b) | 1<<uint(c) | 1<<uint(d) | 1<<uint(e)
+						counts[EvaluateSet(hand).Category()]++
+					}
+				}
+			}
+		}
+	}
+	assert.Equal(map[Category]int{
+		RoyalFlush:    4,
+		StraightFlush: 36,
+		FourOfAKind:   624,
+		FullHouse:     3744,
+		Flush:         5108,
+		Straight:      10200,
+		ThreeOfAKind:  54912,
+		TwoPair:       123552,
+		OnePair:       1098240,
+		HighCard:      1302540,
+	}, counts)
+}
+
+func BenchmarkEvaluateSet(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	hands := make([]cards.CardSet, 1024)
+	for i := range hands {
+		for _, index := range r.Perm(52)[:7] {
+			hands[i] |= 1 << uint(index)
+		}
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		EvaluateSet(hands[i%len(hands)])
+	}
+}
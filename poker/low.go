@@ -0,0 +1,141 @@
+package poker
+
+import (
+	"sort"
+
+	"github.com/anthonyrouseau/games/cards"
+)
+
+//LowRule is a way of ranking hands where the lowest hand wins.
+type LowRule int
+
+//LowRule values
+const (
+	//AceToFive ranks Aces low and ignores straights and flushes, the best hand is A-2-3-4-5.
+	AceToFive LowRule = iota + 1
+	//DeuceToSeven ranks Aces high and counts straights and flushes against the hand,
+	//the best hand is 2-3-4-5-7 of mixed suits.
+	DeuceToSeven
+	//EightOrBetter ranks hands as AceToFive but only five different ranks of Eight or lower qualify.
+	EightOrBetter
+)
+
+//LowValue is the strength of a hand under a LowRule, a smaller LowValue is a better hand.
+//
+//Equal values are ties.
+type LowValue uint32
+
+//LowResult is the evaluation of a hand under a LowRule.
+type LowResult struct {
+	//Qualified is false when no five cards qualify under the rule.
+	//The Value and Best of an unqualified result are empty.
+	Qualified bool
+	Value     LowValue
+	//Best holds the five cards making the hand ordered from most to least significant.
+	Best []cards.Card
+}
+
+//Beats returns true if the result is a better low hand than other.
+//
+//A qualified hand beats any hand that did not qualify.
+func (r LowResult) Beats(other LowResult) bool {
+	if !r.Qualified || !other.Qualified {
+		return r.Qualified && !other.Qualified
+	}
+	return r.Value < other.Value
+}
+
+//EvaluateLow returns the best five card low hand from 5 to 7 cards under the rule.
+//
+//Errors if there are not 5 to 7 cards, any card is a joker or invalid,
+//a card is repeated or the rule is unknown.
+func EvaluateLow(cs []cards.Card, rule LowRule) (LowResult, error) {
+	if _, err := newHandSet(cs); err != nil {
+		return LowResult{}, err
+	}
+	if rule < AceToFive || rule > EightOrBetter {
+		return LowResult{}, &InvalidLowRule{rule: rule}
+	}
+	var best LowResult
+	five := make([]cards.Card, 5)
+	forEachCombination(len(cs), 5, func(indices []int) {
+		for i, index := range indices {
+			five[i] = cs[index]
+		}
+		if result := evaluateLowFive(five, rule); result.Beats(best) {
+			best = result
+		}
+	})
+	return best, nil
+}
+
+//EvaluateHandLow returns the best five card low hand from the cards in a hand under the rule.
+func EvaluateHandLow(h *cards.Hand, rule LowRule) (LowResult, error) {
+	return EvaluateLow(h.Cards(), rule)
+}
+
+//evaluateLowFive returns the low result of exactly five cards.
+func evaluateLowFive(five []cards.Card, rule LowRule) LowResult {
+	if rule == DeuceToSeven {
+		value := EvaluateSet(cards.NewCardSet(five...))
+		if value.ranks()[0] == fiveIndex {
+			//The wheel is not a straight when Aces are high.
+			switch value.Category() {
+			case Straight:
+				value = newValue(HighCard, topFive[wheelMask])
+			case StraightFlush:
+				value = newValue(Flush, topFive[wheelMask])
+			}
+		}
+		return LowResult{Qualified: true, Value: LowValue(value), Best: bestCards(five, value)}
+	}
+	var counts [rankCount]int
+	for _, card := range five {
+		counts[aceLowIndex(card.Rank())]++
+	}
+	best := append([]cards.Card{}, five...)
+	sort.Slice(best, func(i, j int) bool {
+		a, b := aceLowIndex(best[i].Rank()), aceLowIndex(best[j].Rank())
+		if counts[a] != counts[b] {
+			return counts[a] > counts[b]
+		}
+		return a > b
+	})
+	groups := []int{}
+	var ranks uint32
+	for i, card := range best {
+		rank := aceLowIndex(card.Rank())
+		if i == 0 || rank != aceLowIndex(best[i-1].Rank()) {
+			groups = append(groups, counts[rank])
+			ranks = ranks<<rankBits | uint32(rank)
+		}
+	}
+	ranks <<= uint(5-len(groups)) * rankBits
+	category := groupCategory(groups)
+	if rule == EightOrBetter && (category != HighCard || aceLowIndex(best[0].Rank()) > aceLowIndex(cards.Eight)) {
+		return LowResult{}
+	}
+	return LowResult{Qualified: true, Value: LowValue(newValue(category, ranks)), Best: best}
+}
+
+//groupCategory returns the category of five cards with the given rank counts ordered from most to fewest.
+func groupCategory(groups []int) Category {
+	switch {
+	case groups[0] == 4:
+		return FourOfAKind
+	case groups[0] == 3 && groups[1] == 2:
+		return FullHouse
+	case groups[0] == 3:
+		return ThreeOfAKind
+	case groups[0] == 2 && groups[1] == 2:
+		return TwoPair
+	case groups[0] == 2:
+		return OnePair
+	}
+	return HighCard
+}
+
+//aceLowIndex returns the index of a rank with Aces low.
+func aceLowIndex(rank cards.Rank) int {
+	return int(rank) - 1
+}
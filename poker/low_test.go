@@ -0,0 +1,171 @@
+package poker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvaluateLowOrder(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		rule  LowRule
+		hands []string
+	}{
+		{AceToFive, []string{
+			"5h 4h 3h 2h Ah",
+			"6c 4d 3h 2s Ac",
+			"6c 5d 4h 3s 2c",
+			"Kc Qd Jh Ts 9c",
+			"Ac Ad 2h 3s 4c",
+			"2c 2d 3h 3s 4c",
+		}},
+		{DeuceToSeven, []string{
+			"7c 5d 4h 3s 2c",
+			"7c 6d 4h 3s 2c",
+			"8c 6d 5h 4s 3c",
+			"Kc Qd Jh Ts 8c",
+			"Ac 5d 4h 3s 2c",
+			"2c 2d 4h 5s 7c",
+			"6c 5d 4h 3s 2c",
+			"7h 5h 4h 3h 2h",
+		}},
+		{EightOrBetter, []string{
+			"5h 4h 3h 2h Ah",
+			"7c 5d 4h 3s 2c",
+			"8c 7d 6h 5s 4c",
+		}},
+	}
+	for _, test := range tests {
+		var previous LowResult
+		for i, hand := range test.hands {
+			result, err := EvaluateLow(mustParse(hand), test.rule)
+			if !assert.NoError(err, hand) {
+				continue
+			}
+			assert.True(result.Qualified, hand)
+			if i > 0 {
+				assert.True(previous.Beats(result), "%s should beat %s", test.hands[i-1], hand)
+			}
+			previous = result
+		}
+	}
+}
+
+func TestEvaluateLowBest(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		hand      string
+		rule      LowRule
+		qualified bool
+		best      string
+	}{
+		{"Kc 7d 5h 4s 3c Ah Ad", AceToFive, true, "7d 5h 4s 3c Ah"},
+		{"Kc Kd Kh 2s 2c 3h 3d", AceToFive, true, "3h 3d 2s 2c Kc"},
+		{"Ac 5d 4h 3s 2c 7d 9h", DeuceToSeven, true, "7d 5d 4h 3s 2c"},
+		{"9c Td Jh 2s 3c 4h Kd", EightOrBetter, false, ""},
+		{"9c 8d 6h 2s 2c 4h Ad", EightOrBetter, true, "8d 6h 4h 2s Ad"},
+		{"9c 8d 6h 2s 2c 4h", EightOrBetter, false, ""},
+	}
+	for _, test := range tests {
+		result, err := EvaluateLow(mustParse(test.hand), test.rule)
+		if !assert.NoError(err, test.hand) {
+			continue
+		}
+		assert.Equal(test.qualified, result.Qualified, test.hand)
+		if test.qualified {
+			assert.Equal(mustParse(test.best), result.Best, test.hand)
+		}
+	}
+}
+
+func TestEvaluateLowTie(t *testing.T) {
+	assert := assert.New(t)
+	a, _ := EvaluateLow(mustParse("5h 4h 3h 2h Ah"), AceToFive)
+	b, _ := EvaluateLow(mustParse("5c 4d 3s 2c Ad"), AceToFive)
+	assert.Equal(a.Value, b.Value)
+	assert.False(a.Beats(b))
+	assert.False(b.Beats(a))
+	assert.True(a.Beats(LowResult{}))
+	assert.False(LowResult{}.Beats(a))
+}
+
+func TestEvaluateLowErrors(t *testing.T) {
+	assert := assert.New(t)
+	_, err := EvaluateLow(mustParse("As Ks Qs Js"), AceToFive)
+	assert.IsType(&InvalidHandSize{}, err)
+	_, err = EvaluateLow(mustParse("As Ks Qs Js JK"), AceToFive)
+	assert.IsType(&InvalidCards{}, err)
+	_, err = EvaluateLow(mustParse("As Ks Qs Js Ts"), LowRule(0))
+	assert.IsType(&InvalidLowRule{}, err)
+}
+
+func TestEvaluateOmaha(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		hole     string
+		board    string
+		category Category
+		best     string
+	}{
+		{"As Ks 2c 3d", "Qs Js Ts 4h 5h", RoyalFlush, "As Ks Qs Js Ts"},
+		{"Ah Kc Qc Jc", "9h 8h 7h 6h 2c", HighCard, "Ah Kc 9h 8h 7h"},
+		{"As Ks Qd Jd", "Ah Ad Ac 2s 3s", FourOfAKind, "Ah Ad Ac Ks As"},
+		{"Kc Kd Kh Ks", "2c 7d 9h", OnePair, "Kc Kd 9h 7d 2c"},
+	}
+	for _, test := range tests {
+		result, err := EvaluateOmaha(mustParse(test.hole), mustParse(test.board))
+		if !assert.NoError(err, test.hole) {
+			continue
+		}
+		assert.Equal(test.category, result.Category, test.hole)
+		assert.ElementsMatch(mustParse(test.best), result.Best, test.hole)
+	}
+}
+
+func TestEvaluateOmahaLow(t *testing.T) {
+	assert := assert.New(t)
+	result, err := EvaluateOmahaLow(mustParse("Ah 2h Kc Kd"), mustParse("3s 4d 7c Kh 9s"), EightOrBetter)
+	assert.NoError(err)
+	assert.True(result.Qualified)
+	assert.ElementsMatch(mustParse("Ah 2h 3s 4d 7c"), result.Best)
+	result, err = EvaluateOmahaLow(mustParse("Ah Kc Qc Jc"), mustParse("2s 3d 4c 5h 9s"), EightOrBetter)
+	assert.NoError(err)
+	assert.False(result.Qualified)
+	_, err = EvaluateOmahaLow(mustParse("Ah 2h Kc"), mustParse("3s 4d 7c"), EightOrBetter)
+	assert.IsType(&InvalidHandSize{}, err)
+	_, err = EvaluateOmaha(mustParse("Ah 2h Kc Kd"), mustParse("Ah 4d 7c"))
+	assert.IsType(&RepeatedCards{}, err)
+}
+
+func TestSplitPot(t *testing.T) {
+	assert := assert.New(t)
+	qualified := LowResult{Qualified: true, Value: 10}
+	better := LowResult{Qualified: true, Value: 5}
+	tests := []struct {
+		pot    int
+		highs  []Value
+		lows   []LowResult
+		shares []int
+	}{
+		{100, []Value{3, 2}, nil, []int{100, 0}},
+		{100, []Value{3, 3, 1}, nil, []int{50, 50, 0}},
+		{101, []Value{3, 2}, []LowResult{{}, qualified}, []int{51, 50}},
+		{100, []Value{3, 2}, []LowResult{{}, {}}, []int{100, 0}},
+		{100, []Value{3, 2, 1}, []LowResult{better, qualified, better}, []int{75, 0, 25}},
+		{7, []Value{3, 3, 1}, []LowResult{{}, {}, qualified}, []int{2, 2, 3}},
+		{100, []Value{}, nil, []int{}},
+	}
+	for _, test := range tests {
+		shares, err := SplitPot(test.pot, test.highs, test.lows)
+		assert.NoError(err)
+		assert.Equal(test.shares, shares)
+	}
+
+	_, err := SplitPot(100, []Value{3, 2}, []LowResult{qualified, better, better})
+	assert.IsType(&MismatchedResults{}, err)
+	_, err = SplitPot(100, []Value{3, 2, 1}, []LowResult{better})
+	assert.IsType(&MismatchedResults{}, err)
+	_, err = SplitPot(100, []Value{3, 2}, []LowResult{})
+	assert.IsType(&MismatchedResults{}, err)
+}
@@ -0,0 +1,69 @@
+package poker
+
+import (
+	"github.com/anthonyrouseau/games/cards"
+)
+
+//EvaluateOmaha returns the best Omaha high hand made from exactly two hole cards and three board cards.
+//
+//Hole cards may number 4 to 6 for Omaha variants and the board 3 to 5.
+//Errors if the number of hole or board cards is not allowed,
+//any card is a joker or invalid, or a card is repeated.
+func EvaluateOmaha(hole, board []cards.Card) (Result, error) {
+	if err := checkOmaha(hole, board); err != nil {
+		return Result{}, err
+	}
+	var best Result
+	forEachOmaha(hole, board, func(five []cards.Card) {
+		if value := EvaluateSet(cards.NewCardSet(five...)); value > best.Value {
+			best.Value = value
+			best.Category = value.Category()
+			best.Best = bestCards(five, value)
+		}
+	})
+	return best, nil
+}
+
+//EvaluateOmahaLow returns the best Omaha low hand under the rule made from exactly two hole cards and three board cards.
+//
+//Omaha Hi/Lo uses the EightOrBetter rule.
+func EvaluateOmahaLow(hole, board []cards.Card, rule LowRule) (LowResult, error) {
+	if err := checkOmaha(hole, board); err != nil {
+		return LowResult{}, err
+	}
+	if rule < AceToFive || rule > EightOrBetter {
+		return LowResult{}, &InvalidLowRule{rule: rule}
+	}
+	var best LowResult
+	forEachOmaha(hole, board, func(five []cards.Card) {
+		if result := evaluateLowFive(five, rule); result.Beats(best) {
+			best = result
+		}
+	})
+	return best, nil
+}
+
+func checkOmaha(hole, board []cards.Card) error {
+	if len(hole) < 4 || len(hole) > 6 {
+		return &InvalidHandSize{size: len(hole)}
+	}
+	if len(board) < 3 || len(board) > 5 {
+		return &InvalidHandSize{size: len(board)}
+	}
+	_, err := newSet(append(append([]cards.Card{}, hole...), board...))
+	return err
+}
+
+//forEachOmaha calls f with every five cards made of two hole cards followed by three board cards.
+func forEachOmaha(hole, board []cards.Card, f func(five []cards.Card)) {
+	five := make([]cards.Card, 5)
+	forEachCombination(len(hole), 2, func(holeIndices []int) {
+		five[0], five[1] = hole[holeIndices[0]], hole[holeIndices[1]]
+		forEachCombination(len(board), 3, func(boardIndices []int) {
+			for i, index := range boardIndices {
+				five[2+i] = board[index]
+			}
+			f(five)
+		})
+	})
+}
@@ -0,0 +1,201 @@
+package poker
+
+import (
+	"strings"
+
+	"github.com/anthonyrouseau/games/cards"
+)
+
+//Combo is a pair of hole cards.
+type Combo [2]cards.Card
+
+//Range is the set of hole cards a player may hold.
+type Range struct {
+	combos []cards.CardSet
+}
+
+//HoleCards returns the range of a player known to hold exactly two cards.
+//
+//Errors if a card is not a standard card or both are the same card.
+func HoleCards(first, second cards.Card) (Range, error) {
+	for _, card := range []cards.Card{first, second} {
+		if card.Index() < 0 || card.Index() >= 52 {
+			return Range{}, &InvalidCards{cards: []cards.Card{card}}
+		}
+	}
+	if first == second {
+		return Range{}, &RepeatedCards{cards: []cards.Card{first}}
+	}
+	return Range{combos: []cards.CardSet{cards.NewCardSet(first, second)}}, nil
+}
+
+//ParseRange returns the range written in the usual hand range notation with parts separated by commas.
+//
+//Each part is one of:
+//
+//	AhKh     a single combo of two cards
+//	QQ       every combo of a pair
+//	AKs AKo  every suited or offsuit combo of two ranks, AK for both
+//	QQ+ ATs+ the hand and every better pair or better kicker below the high card
+//	22-55    every pair between two pairs, A2s-A5s every kicker between two hands with the same high card
+//
+//Errors with InvalidRange listing every invalid part.
+func ParseRange(s string) (Range, error) {
+	var r Range
+	seen := map[cards.CardSet]bool{}
+	invalid := []string{}
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		combos, ok := parseRangePart(part)
+		if !ok {
+			invalid = append(invalid, part)
+			continue
+		}
+		for _, combo := range combos {
+			if !seen[combo] {
+				seen[combo] = true
+				r.combos = append(r.combos, combo)
+			}
+		}
+	}
+	if len(invalid) > 0 {
+		return Range{}, &InvalidRange{notations: invalid}
+	}
+	return r, nil
+}
+
+//Combos returns the combos in the range with the cards of each ordered by index.
+func (r Range) Combos() []Combo {
+	combos := make([]Combo, len(r.combos))
+	for i, combo := range r.combos {
+		copy(combos[i][:], combo.Cards())
+	}
+	return combos
+}
+
+//Size returns the number of combos in the range.
+func (r Range) Size() int {
+	return len(r.combos)
+}
+
+//handClass is a pair of ranks with Aces high that are suited, offsuit or either.
+type handClass struct {
+	high, low int
+	suited    byte
+}
+
+func parseRangePart(part string) ([]cards.CardSet, bool) {
+	if len(part) == 4 {
+		first, firstErr := cards.ParseCard(part[:2])
+		second, secondErr := cards.ParseCard(part[2:])
+		if firstErr == nil && secondErr == nil {
+			if first == second || first.Index() >= 52 || second.Index() >= 52 {
+				return nil, false
+			}
+			return []cards.CardSet{cards.NewCardSet(first, second)}, true
+		}
+	}
+	classes := []handClass{}
+	switch {
+	case strings.HasSuffix(part, "+"):
+		class, ok := parseHandClass(part[:len(part)-1])
+		if !ok {
+			return nil, false
+		}
+		if class.high == class.low {
+			for pair := class.high; pair <= aceIndex; pair++ {
+				classes = append(classes, handClass{high: pair, low: pair})
+			}
+		} else {
+			for low := class.low; low < class.high; low++ {
+				classes = append(classes, handClass{high: class.high, low: low, suited: class.suited})
+			}
+		}
+	case strings.Contains(part, "-"):
+		ends := strings.SplitN(part, "-", 2)
+		from, fromOk := parseHandClass(ends[0])
+		to, toOk := parseHandClass(ends[1])
+		if !fromOk || !toOk || from.suited != to.suited || (from.high == from.low) != (to.high == to.low) {
+			return nil, false
+		}
+		if from.high == from.low {
+			if from.high > to.high {
+				from, to = to, from
+			}
+			for pair := from.high; pair <= to.high; pair++ {
+				classes = append(classes, handClass{high: pair, low: pair})
+			}
+		} else {
+			if from.high != to.high {
+				return nil, false
+			}
+			if from.low > to.low {
+				from, to = to, from
+			}
+			for low := from.low; low <= to.low; low++ {
+				classes = append(classes, handClass{high: from.high, low: low, suited: from.suited})
+			}
+		}
+	default:
+		class, ok := parseHandClass(part)
+		if !ok {
+			return nil, false
+		}
+		classes = append(classes, class)
+	}
+	combos := []cards.CardSet{}
+	for _, class := range classes {
+		combos = append(combos, class.combos()...)
+	}
+	return combos, true
+}
+
+//parseHandClass parses two ranks followed by an optional "s" or "o".
+func parseHandClass(s string) (handClass, bool) {
+	var class handClass
+	if len(s) == 3 {
+		class.suited = strings.ToLower(s[2:])[0]
+		if class.suited != 's' && class.suited != 'o' {
+			return handClass{}, false
+		}
+		s = s[:2]
+	}
+	if len(s) != 2 {
+		return handClass{}, false
+	}
+	first, firstErr := cards.ParseCard(s[:1] + "s")
+	second, secondErr := cards.ParseCard(s[1:] + "s")
+	if firstErr != nil || secondErr != nil {
+		return handClass{}, false
+	}
+	class.high, class.low = rankIndex(first.Rank()), rankIndex(second.Rank())
+	if class.high < class.low {
+		class.high, class.low = class.low, class.high
+	}
+	if class.high == class.low && class.suited != 0 {
+		return handClass{}, false
+	}
+	return class, true
+}
+
+//combos returns every combo of the class.
+func (c handClass) combos() []cards.CardSet {
+	combos := []cards.CardSet{}
+	for i, firstSuit := range standardSuits {
+		for j, secondSuit := range standardSuits {
+			if c.high == c.low && j <= i {
+				continue
+			}
+			if (c.suited == 's' && i != j) || (c.suited == 'o' && i == j) {
+				continue
+			}
+			first, _ := cards.NewCard(rankFromIndex(c.high), firstSuit)
+			second, _ := cards.NewCard(rankFromIndex(c.low), secondSuit)
+			combos = append(combos, cards.NewCardSet(first, second))
+		}
+	}
+	return combos
+}
@@ -0,0 +1,68 @@
+package poker
+
+import (
+	"testing"
+
+	"github.com/anthonyrouseau/games/cards"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRange(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		notation string
+		size     int
+	}{
+		{"AA", 6},
+		{"AKs", 4},
+		{"AKo", 12},
+		{"AK", 16},
+		{"KA", 16},
+		{"QQ+, AKs", 22},
+		{"22-55", 24},
+		{"55-22", 24},
+		{"A2s+", 48},
+		{"KTo+", 36},
+		{"A2s-A5s", 16},
+		{"AhKh", 1},
+		{"AhKh, AKs", 4},
+		{"", 0},
+	}
+	for _, test := range tests {
+		r, err := ParseRange(test.notation)
+		if assert.NoError(err, test.notation) {
+			assert.Equal(test.size, r.Size(), test.notation)
+			assert.Len(r.Combos(), test.size, test.notation)
+		}
+	}
+}
+
+func TestParseRangeCombos(t *testing.T) {
+	assert := assert.New(t)
+	r, err := ParseRange("AKs")
+	assert.NoError(err)
+	for _, combo := range r.Combos() {
+		assert.Equal(combo[0].Suit(), combo[1].Suit())
+		assert.ElementsMatch([]cards.Rank{cards.Ace, cards.King}, []cards.Rank{combo[0].Rank(), combo[1].Rank()})
+	}
+	r, err = HoleCards(mustParse("Ah")[0], mustParse("Kd")[0])
+	assert.NoError(err)
+	assert.Equal([]Combo{{mustParse("Kd")[0], mustParse("Ah")[0]}}, r.Combos())
+
+	_, err = HoleCards(mustParse("Ah")[0], mustParse("Ah")[0])
+	assert.IsType(&RepeatedCards{}, err)
+	_, err = HoleCards(mustParse("Ah")[0], mustParse("JK")[0])
+	assert.IsType(&InvalidCards{}, err)
+	_, err = HoleCards(cards.Card{}, mustParse("Ah")[0])
+	assert.IsType(&InvalidCards{}, err)
+}
+
+func TestParseRangeErrors(t *testing.T) {
+	assert := assert.New(t)
+	for _, notation := range []string{"AAs", "ZZ", "AK-QJ", "22-AKs", "AhAh", "AKx", "A", "QQ+, AKq"} {
+		_, err := ParseRange(notation)
+		assert.IsType(&InvalidRange{}, err, notation)
+	}
+	_, err := ParseRange("AKq, QQ, 7")
+	assert.EqualError(err, `Ranges ["AKq" "7"] are not valid hand ranges.`)
+}
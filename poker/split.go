@@ -0,0 +1,60 @@
+package poker
+
+//SplitPot divides a pot between players by their high values and low results.
+//
+//Half of the pot goes to the best high hands and half to the best qualifying low hands.
+//When no low hand qualifies, or lows is nil, the whole pot goes to the best high hands.
+//Tied players share their half equally and chips that can not be split
+//go to the high half and then to the earliest players.
+//Returns the amount won by each player.
+//Errors if lows is not nil and does not hold a result for each player.
+func SplitPot(pot int, highs []Value, lows []LowResult) ([]int, error) {
+	if lows != nil && len(lows) != len(highs) {
+		return nil, &MismatchedResults{highs: len(highs), lows: len(lows)}
+	}
+	shares := make([]int, len(highs))
+	if len(highs) == 0 {
+		return shares, nil
+	}
+	var bestLow LowResult
+	for _, low := range lows {
+		if low.Beats(bestLow) {
+			bestLow = low
+		}
+	}
+	highPot := pot
+	if bestLow.Qualified {
+		highPot = pot - pot/2
+		lowWinners := []int{}
+		for i, low := range lows {
+			if low.Qualified && low.Value == bestLow.Value {
+				lowWinners = append(lowWinners, i)
+			}
+		}
+		award(shares, pot/2, lowWinners)
+	}
+	var bestHigh Value
+	for _, high := range highs {
+		if high > bestHigh {
+			bestHigh = high
+		}
+	}
+	highWinners := []int{}
+	for i, high := range highs {
+		if high == bestHigh {
+			highWinners = append(highWinners, i)
+		}
+	}
+	award(shares, highPot, highWinners)
+	return shares, nil
+}
+
+//award splits amount between the winners giving odd chips to the earliest winners.
+func award(shares []int, amount int, winners []int) {
+	for i, winner := range winners {
+		shares[winner] += amount / len(winners)
+		if i < amount%len(winners) {
+			shares[winner]++
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package poker
+
+//Category is the type of a poker hand e.g. a Flush.
+//
+//FiveOfAKind is only possible with wild cards.
+type Category int
+
+//Category values from worst to best
+const (
+	HighCard Category = iota + 1
+	OnePair
+	TwoPair
+	ThreeOfAKind
+	Straight
+	Flush
+	FullHouse
+	FourOfAKind
+	StraightFlush
+	RoyalFlush
+	FiveOfAKind
+)
+
+var categoryNames = map[Category]string{
+	HighCard:      "High Card",
+	OnePair:       "One Pair",
+	TwoPair:       "Two Pair",
+	ThreeOfAKind:  "Three of a Kind",
+	Straight:      "Straight",
+	Flush:         "Flush",
+	FullHouse:     "Full House",
+	FourOfAKind:   "Four of a Kind",
+	StraightFlush: "Straight Flush",
+	RoyalFlush:    "Royal Flush",
+	FiveOfAKind:   "Five of a Kind",
+}
+
+func (c Category) String() string {
+	if name, ok := categoryNames[c]; ok {
+		return name
+	}
+	return "Unknown"
+}
+
+//Value is the strength of a poker hand, a greater Value is a better hand.
+//
+//The category is held in the high bits followed by the ranks
+//that decide between hands of the same category, kickers included.
+//Equal values are ties.
+type Value uint32
+
+const (
+	categoryShift = 20
+	rankBits      = 4
+	rankMask      = 1<<rankBits - 1
+)
+
+//Category returns the category of the hand.
+func (v Value) Category() Category {
+	return Category(v >> categoryShift)
+}
+
+//ranks returns the rank indices held by the value from most to least significant.
+//
+//Rank indices are 0 for a Two up to 12 for an Ace.
+func (v Value) ranks() [5]int {
+	var ranks [5]int
+	for i := range ranks {
+		ranks[i] = int(v>>(uint(4-i)*rankBits)) & rankMask
+	}
+	return ranks
+}
+
+func newValue(category Category, ranks uint32) Value {
+	return Value(uint32(category)<<categoryShift | ranks)
+}
@@ -0,0 +1,252 @@
+package poker
+
+import (
+	"github.com/anthonyrouseau/games/cards"
+)
+
+//Substitution is a wild card and the card it stands in for.
+type Substitution struct {
+	Wild cards.Card
+	As   cards.Card
+}
+
+//Common sets of wild cards.
+var (
+	JokersWild       = wildSet("jk JK")
+	DeucesWild       = wildSet("2c 2d 2h 2s")
+	OneEyedJacksWild = wildSet("Js Jh")
+)
+
+func wildSet(notation string) cards.CardSet {
+	cs, err := cards.ParseCards(notation)
+	if err != nil {
+		panic(err)
+	}
+	return cards.NewCardSet(cs...)
+}
+
+//standardSuits are the suits a wild card may stand in for, in the order they are tried.
+var standardSuits = []cards.SuitName{cards.Spades, cards.Hearts, cards.Diamonds, cards.Clubs}
+
+//EvaluateWild returns the best five card poker hand from 5 to 7 cards where the cards in wilds are wild.
+//
+//Each wild card stands in for whichever card makes the best hand,
+//including a card already in the hand which allows FiveOfAKind.
+//The Substitutions of the result report the card each wild card in the best hand stood in for.
+//Jokers are only allowed when they are wild and repeated wild cards are allowed
+//for decks holding more than one copy of them.
+func EvaluateWild(cs []cards.Card, wilds cards.CardSet) (Result, error) {
+	if len(cs) < 5 || len(cs) > 7 {
+		return Result{}, &InvalidHandSize{size: len(cs)}
+	}
+	var naturals []cards.Card
+	for _, card := range cs {
+		if !wilds.Contains(card) {
+			naturals = append(naturals, card)
+		}
+	}
+	if _, err := newSet(naturals); err != nil {
+		return Result{}, err
+	}
+	if len(naturals) == len(cs) {
+		return Evaluate(cs)
+	}
+	var best Result
+	combination := make([]cards.Card, 5)
+	forEachCombination(len(cs), 5, func(indices []int) {
+		for i, index := range indices {
+			combination[i] = cs[index]
+		}
+		result := evaluateWildFive(combination, wilds)
+		if result.Value > best.Value {
+			best = result
+		}
+	})
+	return best, nil
+}
+
+//EvaluateHandWild returns the best five card poker hand from the cards in a hand where the cards in wilds are wild.
+func EvaluateHandWild(h *cards.Hand, wilds cards.CardSet) (Result, error) {
+	return EvaluateWild(h.Cards(), wilds)
+}
+
+//forEachCombination calls f with each combination of k indices below n in increasing order.
+func forEachCombination(n, k int, f func(indices []int)) {
+	indices := make([]int, k)
+	for i := range indices {
+		indices[i] = i
+	}
+	for {
+		f(indices)
+		i := k - 1
+		for i >= 0 && indices[i] == n-k+i {
+			i--
+		}
+		if i < 0 {
+			return
+		}
+		indices[i]++
+		for j := i + 1; j < k; j++ {
+			indices[j] = indices[j-1] + 1
+		}
+	}
+}
+
+//evaluateWildFive returns the best hand from exactly five cards some of which are wild.
+//
+//Rather than trying every card for every wild card, candidate hands are built
+//for each way wild cards can improve a hand and the best candidate is kept:
+//adding to the ranks already held, completing a straight or straight flush,
+//and completing a flush with the highest missing ranks.
+func evaluateWildFive(five []cards.Card, wilds cards.CardSet) Result {
+	var naturals, wild []cards.Card
+	for _, card := range five {
+		if wilds.Contains(card) {
+			wild = append(wild, card)
+		} else {
+			naturals = append(naturals, card)
+		}
+	}
+	var best Result
+	try := func(substitutes []cards.Card) {
+		hand := append(append([]cards.Card{}, naturals...), substitutes...)
+		value := evaluateFive(hand)
+		if value <= best.Value {
+			return
+		}
+		best.Value = value
+		best.Category = value.Category()
+		best.Best = bestCards(hand, value)
+		best.Substitutions = make([]Substitution, len(wild))
+		for i, card := range wild {
+			best.Substitutions[i] = Substitution{Wild: card, As: substitutes[i]}
+		}
+	}
+	held := map[int]bool{aceIndex: true}
+	for _, card := range naturals {
+		held[rankIndex(card.Rank())] = true
+	}
+	ranks := make([]int, 0, len(held))
+	for rank := aceIndex; rank >= 0; rank-- {
+		if held[rank] {
+			ranks = append(ranks, rank)
+		}
+	}
+	forEachMultiset(len(ranks), len(wild), func(choices []int) {
+		substitutes := make([]cards.Card, len(choices))
+		hand := append([]cards.Card{}, naturals...)
+		for i, choice := range choices {
+			substitutes[i] = unusedSuit(hand, ranks[choice])
+			hand = append(hand, substitutes[i])
+		}
+		try(substitutes)
+	})
+	for _, suit := range standardSuits {
+		for high := aceIndex; high >= fiveIndex; high-- {
+			if substitutes, ok := completeStraight(naturals, len(wild), high, suit); ok {
+				try(substitutes)
+			}
+		}
+		if substitutes, ok := completeFlush(naturals, len(wild), suit); ok {
+			try(substitutes)
+		}
+	}
+	return best
+}
+
+//forEachMultiset calls f with each way of choosing k values below n with repetition in increasing order.
+func forEachMultiset(n, k int, f func(choices []int)) {
+	choices := make([]int, k)
+	for {
+		f(choices)
+		i := k - 1
+		for i >= 0 && choices[i] == n-1 {
+			i--
+		}
+		if i < 0 {
+			return
+		}
+		choices[i]++
+		for j := i + 1; j < k; j++ {
+			choices[j] = choices[i]
+		}
+	}
+}
+
+//evaluateFive returns the value of five cards which may hold five of a kind.
+func evaluateFive(hand []cards.Card) Value {
+	rank := rankIndex(hand[0].Rank())
+	for _, card := range hand[1:] {
+		if rankIndex(card.Rank()) != rank {
+			return EvaluateSet(cards.NewCardSet(hand...))
+		}
+	}
+	return newValue(FiveOfAKind, uint32(rank)<<16)
+}
+
+//rankFromIndex returns the rank for an index with Aces high.
+func rankFromIndex(index int) cards.Rank {
+	if index == aceIndex {
+		return cards.Ace
+	}
+	return cards.Rank(index + 2)
+}
+
+//unusedSuit returns a card of the rank in a suit not yet in the hand, a Spade if every suit is used.
+func unusedSuit(hand []cards.Card, rank int) cards.Card {
+	set := cards.NewCardSet(hand...)
+	for _, suit := range standardSuits {
+		card, _ := cards.NewCard(rankFromIndex(rank), suit)
+		if !set.Contains(card) {
+			return card
+		}
+	}
+	card, _ := cards.NewCard(rankFromIndex(rank), cards.Spades)
+	return card
+}
+
+//completeStraight returns the cards of the suit filling the straight ending at high around the naturals.
+//
+//Fails if a natural is outside the straight or shares a rank with another.
+func completeStraight(naturals []cards.Card, wilds, high int, suit cards.SuitName) ([]cards.Card, bool) {
+	var run uint32 = 0x1F << uint(high-4)
+	if high == fiveIndex {
+		run = wheelMask
+	}
+	var held uint32
+	for _, card := range naturals {
+		bit := uint32(1) << uint(rankIndex(card.Rank()))
+		if run&bit == 0 || held&bit != 0 {
+			return nil, false
+		}
+		held |= bit
+	}
+	substitutes := make([]cards.Card, 0, wilds)
+	for rank := aceIndex; rank >= 0; rank-- {
+		if run&^held&(1<<uint(rank)) != 0 {
+			card, _ := cards.NewCard(rankFromIndex(rank), suit)
+			substitutes = append(substitutes, card)
+		}
+	}
+	return substitutes, len(substitutes) == wilds
+}
+
+//completeFlush returns the highest cards of the suit missing from the naturals.
+//
+//Fails if a natural is not of the suit.
+func completeFlush(naturals []cards.Card, wilds int, suit cards.SuitName) ([]cards.Card, bool) {
+	set := cards.NewCardSet(naturals...)
+	for _, card := range naturals {
+		if card.Suit().Name() != suit {
+			return nil, false
+		}
+	}
+	substitutes := make([]cards.Card, 0, wilds)
+	for rank := aceIndex; rank >= 0 && len(substitutes) < wilds; rank-- {
+		card, _ := cards.NewCard(rankFromIndex(rank), suit)
+		if !set.Contains(card) {
+			substitutes = append(substitutes, card)
+		}
+	}
+	return substitutes, true
+}
@@ -0,0 +1,119 @@
+package poker
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/anthonyrouseau/games/cards"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvaluateWild(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		hand     string
+		wilds    cards.CardSet
+		category Category
+		best     string
+		as       string
+	}{
+		{"As Ah Ad Ac JK", JokersWild, FiveOfAKind, "As Ah Ad Ac As", "As"},
+		{"Ks Qs Js Ts JK", JokersWild, RoyalFlush, "As Ks Qs Js Ts", "As"},
+		{"9h 8h 6h jk JK 2c 3d", JokersWild, StraightFlush, "Th 9h 8h 7h 6h", "7h Th"},
+		{"9h 7d 2c 2s 5h", DeucesWild, Straight, "9h 8s 7d 6s 5h", "8s 6s"},
+		{"9h 7d 2c 2s 3h", DeucesWild, ThreeOfAKind, "9h 9s 9d 7d 3h", "9s 9d"},
+		{"Kc Kd 5h 2c 3h", DeucesWild, ThreeOfAKind, "Kc Kd Ks 5h 3h", "Ks"},
+		{"Kc Kd 5h 5c 2h", DeucesWild, FullHouse, "Kc Kd Ks 5h 5c", "Ks"},
+		{"Ah 9h 5h 3h Js", OneEyedJacksWild, Flush, "Ah Kh 9h 5h 3h", "Kh"},
+		{"2c 2d 2h 2s 7c", DeucesWild, FiveOfAKind, "7c 7s 7h 7d 7s", "7s 7h 7d 7s"},
+		{"4c 5d 9h Ts JK", JokersWild, OnePair, "Ts Th 9h 5d 4c", "Th"},
+		{"Ac Kc 2c 2d 2h 2s 5c", DeucesWild, FiveOfAKind, "Ac As Ah Ad As", "As Ah Ad As"},
+	}
+	for _, test := range tests {
+		result, err := EvaluateWild(mustParse(test.hand), test.wilds)
+		if !assert.NoError(err, test.hand) {
+			continue
+		}
+		assert.Equal(test.category, result.Category, test.hand)
+		assert.ElementsMatch(mustParse(test.best), result.Best, test.hand)
+		as := make([]cards.Card, len(result.Substitutions))
+		for i, substitution := range result.Substitutions {
+			as[i] = substitution.As
+			assert.True(test.wilds.Contains(substitution.Wild), test.hand)
+		}
+		assert.ElementsMatch(mustParse(test.as), as, test.hand)
+	}
+}
+
+func TestEvaluateWildCompare(t *testing.T) {
+	assert := assert.New(t)
+	wild, _ := EvaluateWild(mustParse("As Ah Ad Ac JK"), JokersWild)
+	royal, _ := Evaluate(mustParse("As Ks Qs Js Ts"))
+	assert.Greater(uint32(wild.Value), uint32(royal.Value))
+	natural, _ := EvaluateWild(mustParse("Ks Kh 9d 7c 4s"), DeucesWild)
+	plain, _ := Evaluate(mustParse("Ks Kh 9d 7c 4s"))
+	assert.Equal(plain.Value, natural.Value)
+	assert.Empty(natural.Substitutions)
+}
+
+func TestEvaluateWildErrors(t *testing.T) {
+	assert := assert.New(t)
+	_, err := EvaluateWild(mustParse("As Ks Qs JK"), JokersWild)
+	assert.IsType(&InvalidHandSize{}, err)
+	_, err = EvaluateWild(mustParse("As Ks Qs Js JK"), DeucesWild)
+	assert.IsType(&InvalidCards{}, err)
+	_, err = EvaluateWild(mustParse("As As Qs Js JK"), JokersWild)
+	assert.IsType(&RepeatedCards{}, err)
+	result, err := EvaluateWild(mustParse("As Ks 2s 2s 2d"), DeucesWild)
+	if assert.NoError(err) {
+		assert.Equal(RoyalFlush, result.Category)
+	}
+}
+
+func TestEvaluateHandWild(t *testing.T) {
+	assert := assert.New(t)
+	hand := cards.NewCardSet(mustParse("Qd Qh 7c 3s JK")...).Hand()
+	result, err := EvaluateHandWild(&hand, JokersWild)
+	if assert.NoError(err) {
+		assert.Equal(ThreeOfAKind, result.Category)
+	}
+}
+
+func TestEvaluateWildBruteForce(t *testing.T) {
+	assert := assert.New(t)
+	deck := cards.NewStandardDeck(true)
+	deck.SetSource(rand.NewSource(9))
+	all := cards.NewStandardDeck(false)
+	standard := all.Cards()
+	for trial := 0; trial < 300; trial++ {
+		deck.Shuffle()
+		hand, _ := deck.Peek([]int{0, 1, 2, 3, 4})
+		result, err := EvaluateWild(hand, JokersWild)
+		if !assert.NoError(err) {
+			continue
+		}
+		var expected Value
+		var wildIndices []int
+		for i, card := range hand {
+			if JokersWild.Contains(card) {
+				wildIndices = append(wildIndices, i)
+			}
+		}
+		substituted := append([]cards.Card{}, hand...)
+		var search func(int)
+		search = func(depth int) {
+			if depth == len(wildIndices) {
+				if value := evaluateFive(substituted); value > expected {
+					expected = value
+				}
+				return
+			}
+			for _, card := range standard {
+				substituted[wildIndices[depth]] = card
+				search(depth + 1)
+			}
+		}
+		search(0)
+		assert.Equal(expected, result.Value, hand)
+	}
+}
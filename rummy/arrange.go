@@ -0,0 +1,190 @@
+package rummy
+
+import (
+	"math/bits"
+
+	"github.com/anthonyrouseau/games/cards"
+)
+
+//Layoff is a card added to one of an opponent's melds.
+type Layoff struct {
+	Card cards.Card
+	//Meld is the index of the meld in the opponent's melds.
+	Meld int
+}
+
+//Arrangement is a way of melding a hand that leaves the least deadwood.
+type Arrangement struct {
+	Melds    []Meld
+	Layoffs  []Layoff
+	Deadwood []cards.Card
+	//Points is the value of the deadwood.
+	Points int
+}
+
+//Arrange returns the melds of the hand leaving the least deadwood.
+//
+//A card that could be in a set or a run goes in whichever leaves less deadwood.
+//Errors if the rules are not valid or the hand holds a joker or a repeated card.
+func Arrange(hand *cards.Hand, rules Rules) (Arrangement, error) {
+	return ArrangeWithLayoffs(hand, nil, rules)
+}
+
+//ArrangeWithLayoffs returns the melds of the hand and the cards laid off on the opponent's melds
+//leaving the least deadwood.
+//
+//Several cards can be laid off on a run one after the other e.g. the 4 and 3 of Hearts on 5-6-7 of Hearts.
+//Errors if the rules are not valid, a meld is not a set or a run,
+//or the hand holds a joker, a repeated card or a card of the melds.
+func ArrangeWithLayoffs(hand *cards.Hand, melds []Meld, rules Rules) (Arrangement, error) {
+	if err := rules.validate(); err != nil {
+		return Arrangement{}, err
+	}
+	held, err := cardSet(hand.Cards())
+	if err != nil {
+		return Arrangement{}, err
+	}
+	melded := held
+	for _, meld := range melds {
+		set, err := cardSet(meld.Cards)
+		if err != nil {
+			return Arrangement{}, err
+		}
+		if checked, ok := newMeld(meld.Cards, rules); !ok || checked.Kind != meld.Kind {
+			return Arrangement{}, &InvalidMeld{meld: meld}
+		}
+		if set&melded != 0 {
+			return Arrangement{}, &InvalidHand{}
+		}
+		melded |= set
+	}
+	return arrange(held, melds, rules), nil
+}
+
+//arrangeGroup is cards that leave the deadwood together, either one of the hand's melds
+//or cards laid off on the opponent's meld numbered layoff.
+type arrangeGroup struct {
+	cards  cards.CardSet
+	meld   Meld
+	layoff int
+}
+
+//arrangeChoice is the least deadwood of some cards, and the group holding their lowest card, -1 if it is deadwood.
+type arrangeChoice struct {
+	points int
+	group  int
+}
+
+//arranger finds the groups leaving the least deadwood, remembering the answer for each set of cards left.
+type arranger struct {
+	rules  Rules
+	groups []arrangeGroup
+	best   map[cards.CardSet]arrangeChoice
+}
+
+//arrange returns the arrangement of the cards held leaving the least deadwood.
+func arrange(held cards.CardSet, opponent []Meld, rules Rules) Arrangement {
+	a := arranger{rules: rules, best: map[cards.CardSet]arrangeChoice{}}
+	for _, meld := range melds(held, rules) {
+		a.groups = append(a.groups, arrangeGroup{cards: cards.NewCardSet(meld.Cards...), meld: meld, layoff: -1})
+	}
+	for i, meld := range opponent {
+		for _, group := range layoffGroups(meld, held, rules) {
+			a.groups = append(a.groups, arrangeGroup{cards: group, layoff: i})
+		}
+	}
+	arrangement := Arrangement{Points: a.deadwood(held)}
+	for left := held; left != 0; {
+		choice := a.best[left]
+		if choice.group < 0 {
+			card := lowest(left)
+			arrangement.Deadwood = append(arrangement.Deadwood, card)
+			left.Remove(card)
+			continue
+		}
+		group := a.groups[choice.group]
+		if group.layoff < 0 {
+			arrangement.Melds = append(arrangement.Melds, group.meld)
+		} else {
+			for _, card := range group.cards.Cards() {
+				arrangement.Layoffs = append(arrangement.Layoffs, Layoff{Card: card, Meld: group.layoff})
+			}
+		}
+		left = left.Difference(group.cards)
+	}
+	return arrangement
+}
+
+//deadwood returns the least deadwood the cards left can be arranged to leave.
+//
+//The lowest card left is either deadwood or in one of the groups it can be in with the other cards left.
+func (a *arranger) deadwood(left cards.CardSet) int {
+	if left == 0 {
+		return 0
+	}
+	if choice, ok := a.best[left]; ok {
+		return choice.points
+	}
+	card := lowest(left)
+	choice := arrangeChoice{points: a.deadwood(left.Difference(cards.NewCardSet(card))) + a.rules.Value(card), group: -1}
+	for i, group := range a.groups {
+		if !group.cards.Contains(card) || group.cards.Difference(left) != 0 {
+			continue
+		}
+		if points := a.deadwood(left.Difference(group.cards)); points <= choice.points {
+			choice = arrangeChoice{points: points, group: i}
+		}
+	}
+	a.best[left] = choice
+	return choice.points
+}
+
+//lowest returns the card with the lowest index in the set.
+func lowest(set cards.CardSet) cards.Card {
+	card, _ := cards.CardFromIndex(bits.TrailingZeros64(uint64(set)))
+	return card
+}
+
+//layoffGroups returns the cards held that can be laid off on a meld together,
+//any one card of the rank of a set or cards in a row at either end of a run.
+func layoffGroups(meld Meld, held cards.CardSet, rules Rules) []cards.CardSet {
+	groups := []cards.CardSet{}
+	if meld.Kind == Set {
+		for _, card := range held.Cards() {
+			if CanLayOff(card, meld, rules) {
+				groups = append(groups, cards.NewCardSet(card))
+			}
+		}
+		return groups
+	}
+	suit := meld.Cards[0].Suit().Name()
+	seen := map[cards.CardSet]bool{}
+	for i, starts := range runStarts(meld, rules) {
+		line := rules.lines()[i]
+		for _, start := range starts {
+			end := start + len(meld.Cards)
+			for _, below := range []bool{true, false} {
+				var group cards.CardSet
+				for distance := 1; len(meld.Cards)+group.Count() < 13; distance++ {
+					at := end - 1 + distance
+					if below {
+						at = start - distance
+					}
+					if at < 0 || at >= len(line) {
+						break
+					}
+					card, _ := cards.NewCard(line[at], suit)
+					if !held.Contains(card) {
+						break
+					}
+					group.Add(card)
+					if !seen[group] {
+						seen[group] = true
+						groups = append(groups, group)
+					}
+				}
+			}
+		}
+	}
+	return groups
+}
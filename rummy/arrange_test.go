@@ -0,0 +1,79 @@
+package rummy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArrange(t *testing.T) {
+	assert := assert.New(t)
+	rules := DefaultRules()
+	tests := []struct {
+		hand     string
+		aces     AceRule
+		melds    int
+		deadwood string
+		points   int
+	}{
+		//The Seven of Hearts is worth more in the run than the set.
+		{"7h 7d 7s 8h 9h 5c", AceLow, 1, "7d 7s 5c", 19},
+		//With four Sevens there is a set left after the run.
+		{"7h 7d 7s 7c 8h 9h", AceLow, 2, "", 0},
+		{"Kc Qd Jh", AceLow, 0, "Kc Qd Jh", 30},
+		{"Qh Kh Ah 5c", AceLow, 0, "Qh Kh Ah 5c", 26},
+		{"Qh Kh Ah 5c", AceHigh, 1, "5c", 5},
+		{"Kh Ah 2h 5c", AceLowOrHigh, 0, "Kh Ah 2h 5c", 18},
+		{"Kh Ah 2h 5c", AceAround, 1, "5c", 5},
+		{"Ac 2c 3c 4c 5d 5h 5s 7h 8h 9h", AceLow, 3, "", 0},
+		{"", AceLow, 0, "", 0},
+	}
+	for _, test := range tests {
+		rules.Aces = test.aces
+		arrangement, err := Arrange(hand(test.hand), rules)
+		assert.NoError(err)
+		assert.Len(arrangement.Melds, test.melds, test.hand)
+		assert.ElementsMatch(mustParse(test.deadwood), arrangement.Deadwood, test.hand)
+		assert.Equal(test.points, arrangement.Points, test.hand)
+		assert.Empty(arrangement.Layoffs)
+	}
+}
+
+func TestArrangeWithLayoffs(t *testing.T) {
+	assert := assert.New(t)
+	rules := DefaultRules()
+	opponent := []Meld{meld("9c 9d 9s"), meld("5h 6h 7h")}
+
+	arrangement, err := ArrangeWithLayoffs(hand("4h 3h 8h Kc"), opponent, rules)
+	assert.NoError(err)
+	assert.ElementsMatch([]Layoff{
+		{Card: mustParse("3h")[0], Meld: 1},
+		{Card: mustParse("4h")[0], Meld: 1},
+		{Card: mustParse("8h")[0], Meld: 1},
+	}, arrangement.Layoffs)
+	assert.Equal(mustParse("Kc"), arrangement.Deadwood)
+	assert.Equal(10, arrangement.Points)
+
+	//Keeping the Nine of Hearts in a run leaves less deadwood than laying it off on the set.
+	arrangement, err = ArrangeWithLayoffs(hand("9h Th Jh 2c"), opponent, rules)
+	assert.NoError(err)
+	assert.Equal([]Meld{meld("9h Th Jh")}, arrangement.Melds)
+	assert.Empty(arrangement.Layoffs)
+	assert.Equal(2, arrangement.Points)
+
+	arrangement, err = ArrangeWithLayoffs(hand("9h Kc 2c"), opponent, rules)
+	assert.NoError(err)
+	assert.Equal([]Layoff{{Card: mustParse("9h")[0], Meld: 0}}, arrangement.Layoffs)
+	assert.Equal(12, arrangement.Points)
+
+	_, err = ArrangeWithLayoffs(hand("9h Kc 2c"), []Meld{{Kind: Run, Cards: mustParse("2d 3d 5d")}}, rules)
+	assert.IsType(&InvalidMeld{}, err)
+	_, err = ArrangeWithLayoffs(hand("9h Kc 2c"), []Meld{{Kind: Run, Cards: mustParse("9c 9d 9s")}}, rules)
+	assert.IsType(&InvalidMeld{}, err)
+	_, err = ArrangeWithLayoffs(hand("9h Kc 5h"), opponent, rules)
+	assert.IsType(&InvalidHand{}, err)
+	_, err = ArrangeWithLayoffs(hand("9h Kc 2c"), []Meld{meld("4s 5s 6s"), meld("6s 7s 8s")}, rules)
+	assert.IsType(&InvalidHand{}, err)
+	_, err = ArrangeWithLayoffs(hand("9h Kc 2c"), opponent, Rules{AcePoints: -1})
+	assert.IsType(&InvalidRules{}, err)
+}
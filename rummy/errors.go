@@ -0,0 +1,54 @@
+package rummy
+
+import (
+	"fmt"
+)
+
+//InvalidRules signals a rule that can not be used.
+//
+//e.g. a negative knock limit.
+type InvalidRules struct {
+	rule string
+}
+
+func (e *InvalidRules) Error() string {
+	return fmt.Sprintf("Rule %s is not valid.", e.rule)
+}
+
+//InvalidHand signals cards that can not be held in a game with one deck.
+//
+//e.g. a joker or a card held twice.
+type InvalidHand struct{}
+
+func (e *InvalidHand) Error() string {
+	return "The hand holds a joker or a repeated card."
+}
+
+//InvalidMeld signals cards that are neither a set nor a run.
+type InvalidMeld struct {
+	meld Meld
+}
+
+func (e *InvalidMeld) Error() string {
+	return fmt.Sprintf("Meld %v is not a set or a run.", e.meld.Cards)
+}
+
+//UnevenHands signals a knocker and defender whose hands can not come from the same deal.
+//
+//e.g. a knocker holding 3 cards against a defender holding 10.
+type UnevenHands struct {
+	knocker, defender int
+}
+
+func (e *UnevenHands) Error() string {
+	return fmt.Sprintf("A knocker holding %d cards can not knock against %d.", e.knocker, e.defender)
+}
+
+//InvalidKnock signals a knock with more deadwood than the rules allow.
+type InvalidKnock struct {
+	deadwood int
+}
+
+func (e *InvalidKnock) Error() string {
+	return fmt.Sprintf("Deadwood of %d is too much to knock.", e.deadwood)
+}
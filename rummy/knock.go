@@ -0,0 +1,76 @@
+package rummy
+
+import (
+	"github.com/anthonyrouseau/games/cards"
+)
+
+//Result is the score of a hand of Gin Rummy ended by a knock.
+type Result struct {
+	//Knocker is the knocker's melds and deadwood.
+	Knocker Arrangement
+	//Defender is the defender's melds, layoffs and deadwood.
+	Defender Arrangement
+	//Gin is true when the knocker has no deadwood so the defender can not lay off.
+	Gin bool
+	//BigGin is true when the knocker melds every card without discarding.
+	BigGin bool
+	//Undercut is true when the defender has no more deadwood than the knocker.
+	Undercut bool
+	//KnockerWins is true if the knocker scores the points, otherwise the defender does.
+	KnockerWins bool
+	Points      int
+}
+
+//Knock returns the score when a player knocks with their hand against the defender's hand.
+//
+//The knocker's hand is counted after their discard, a knocker holding one more card than the defender with no deadwood
+//has big gin. Each player melds their hand to leave the least deadwood and unless the knocker has gin the defender
+//lays off on the knocker's melds. The knocker scores the difference in deadwood, or the defender's deadwood and the
+//gin bonus with gin, but the defender scores the difference and the undercut bonus with no more deadwood than the knocker.
+//Errors if the rules are not valid, the knocker does not hold as many cards as the defender or one more,
+//the knocker has more deadwood than the KnockLimit or any deadwood without discarding,
+//or the hands hold a joker or a repeated card.
+func Knock(knocker, defender *cards.Hand, rules Rules) (Result, error) {
+	if err := rules.validate(); err != nil {
+		return Result{}, err
+	}
+	knockerCards, err := cardSet(knocker.Cards())
+	if err != nil {
+		return Result{}, err
+	}
+	defenderCards, err := cardSet(defender.Cards())
+	if err != nil {
+		return Result{}, err
+	}
+	if knockerCards&defenderCards != 0 {
+		return Result{}, &InvalidHand{}
+	}
+	extra := knocker.CardCount() - defender.CardCount()
+	if extra != 0 && extra != 1 {
+		return Result{}, &UnevenHands{knocker: knocker.CardCount(), defender: defender.CardCount()}
+	}
+	result := Result{Knocker: arrange(knockerCards, nil, rules)}
+	if result.Knocker.Points > rules.KnockLimit || (extra == 1 && result.Knocker.Points > 0) {
+		return Result{}, &InvalidKnock{deadwood: result.Knocker.Points}
+	}
+	if result.Knocker.Points == 0 {
+		result.Defender = arrange(defenderCards, nil, rules)
+		result.Gin = true
+		result.BigGin = extra == 1
+		result.KnockerWins = true
+		result.Points = result.Defender.Points + rules.GinBonus
+		if result.BigGin {
+			result.Points = result.Defender.Points + rules.BigGinBonus
+		}
+		return result, nil
+	}
+	result.Defender = arrange(defenderCards, result.Knocker.Melds, rules)
+	if result.Defender.Points <= result.Knocker.Points {
+		result.Undercut = true
+		result.Points = result.Knocker.Points - result.Defender.Points + rules.UndercutBonus
+		return result, nil
+	}
+	result.KnockerWins = true
+	result.Points = result.Defender.Points - result.Knocker.Points
+	return result, nil
+}
@@ -0,0 +1,63 @@
+package rummy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKnock(t *testing.T) {
+	assert := assert.New(t)
+	rules := DefaultRules()
+	tests := []struct {
+		knocker, defender string
+		gin, bigGin       bool
+		undercut          bool
+		knockerWins       bool
+		points            int
+	}{
+		//The defender lays off the Six, Ten and Jack of Hearts on 7-8-9 of Hearts leaving 39 against 10.
+		{"2c 3c 4c 5d 5h 5s 7h 8h 9h Kd", "Tc 6h Th Jh Js Qs 2d 3d 4d 9s", false, false, false, true, 29},
+		//The defender lays off the Ace of Clubs on 2-3-4 of Clubs to undercut 6 with no deadwood.
+		{"2c 3c 4c 5d 5h 5s 7h 8h 9h 6d", "Ac 2d 3d 4d 2s 3s 4s 6s 7s 8s", false, false, true, false, 31},
+		//No layoffs against gin.
+		{"Ac 2c 3c 4c 5d 5h 5s 7h 8h 9h", "6h Th Jh Js Qs 2d 3d 4d 9s Tc", true, false, false, true, 90},
+		{"Ac 2c 3c 4c 5d 5h 5s 7h 8h 9h Th", "6h Td Jh Js Qs 2d 3d 4d 9s Tc", true, true, false, true, 96},
+		//Equal deadwood is an undercut.
+		{"2c 3c 4c 5d 5h 5s 7h 8h 9h 3d", "2d 2h 2s 6s 7s 8s Td Th Tc 3h", false, false, true, false, 25},
+	}
+	for _, test := range tests {
+		result, err := Knock(hand(test.knocker), hand(test.defender), rules)
+		assert.NoError(err)
+		assert.Equal(test.gin, result.Gin, test.knocker)
+		assert.Equal(test.bigGin, result.BigGin, test.knocker)
+		assert.Equal(test.undercut, result.Undercut, test.knocker)
+		assert.Equal(test.knockerWins, result.KnockerWins, test.knocker)
+		assert.Equal(test.points, result.Points, test.knocker)
+	}
+}
+
+func TestKnockErrors(t *testing.T) {
+	assert := assert.New(t)
+	rules := DefaultRules()
+	_, err := Knock(hand("2c 3c 4c 5d 5h 5s 7h 8h Kd Qd"), hand("Ac 2d 3d 4d 2s 3s 4s 6s 7s 8s"), rules)
+	assert.IsType(&InvalidKnock{}, err)
+	_, err = Knock(hand("2c 3c 4c 5d 5h 5s 7h 8h 9h Kd"), hand("Kd 2d 3d 4d 2s 3s 4s 6s 7s 8s"), rules)
+	assert.IsType(&InvalidHand{}, err)
+	_, err = Knock(hand("2c 3c 4c 5d 5h 5s 7h 8h 9h JK"), hand("Ac 2d 3d 4d 2s 3s 4s 6s 7s 8s"), rules)
+	assert.IsType(&InvalidHand{}, err)
+
+	_, err = Knock(hand("2c 3c 4c"), hand("Ac 2d 3d 4d 2s 3s 4s 6s 7s 8s"), rules)
+	assert.IsType(&UnevenHands{}, err)
+	_, err = Knock(hand("2c 3c 4c 5d 5h 5s 7h 8h 9h Kd Qd Jd"), hand("Ac 2d 3d 4d 2s 3s 4s 6s 7s 8s"), rules)
+	assert.IsType(&UnevenHands{}, err)
+	//Without discarding every card must be melded.
+	_, err = Knock(hand("2c 3c 4c 5d 5h 5s 7h 8h 9h 6d 2h"), hand("Ac 2d 3d 4d 2s 3s 4s 6s 7s 8s"), rules)
+	assert.IsType(&InvalidKnock{}, err)
+
+	invalid := []Rules{{Aces: AceRule(-1)}, {AcePoints: -1}, {KnockLimit: -1}, {GinBonus: -1}, {BigGinBonus: -1}, {UndercutBonus: -1}}
+	for _, rules := range invalid {
+		_, err = Knock(hand("2c 3c 4c"), hand("5c 6c 7c"), rules)
+		assert.IsType(&InvalidRules{}, err)
+	}
+}
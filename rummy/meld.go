@@ -0,0 +1,227 @@
+package rummy
+
+import (
+	"github.com/anthonyrouseau/games/cards"
+)
+
+//MeldKind is whether a meld is a set or a run.
+type MeldKind int
+
+//MeldKind values
+const (
+	//Set is three or four cards of the same rank.
+	Set MeldKind = iota
+	//Run is three or more cards of a suit in a row.
+	Run
+)
+
+var meldKindNames = map[MeldKind]string{
+	Set: "Set",
+	Run: "Run",
+}
+
+//String returns the name of the kind of meld.
+func (k MeldKind) String() string {
+	if name, ok := meldKindNames[k]; ok {
+		return name
+	}
+	return "Unknown"
+}
+
+//Meld is a set or a run.
+//
+//The cards of a run are in order from the lowest.
+type Meld struct {
+	Kind  MeldKind
+	Cards []cards.Card
+}
+
+//NewMeld returns the meld made of the cards, putting the cards of a run in order.
+//
+//Errors if the cards are not a set or a run with the rules or are not standard cards.
+func NewMeld(cs []cards.Card, rules Rules) (Meld, error) {
+	if err := rules.validate(); err != nil {
+		return Meld{}, err
+	}
+	if _, err := cardSet(cs); err != nil {
+		return Meld{}, err
+	}
+	meld, ok := newMeld(cs, rules)
+	if !ok {
+		return Meld{}, &InvalidMeld{meld: Meld{Cards: cs}}
+	}
+	return meld, nil
+}
+
+//Melds returns every set and run that can be made from the cards of the hand, including melds sharing cards.
+//
+//Errors if the rules are not valid or the hand holds a joker or a repeated card.
+func Melds(hand *cards.Hand, rules Rules) ([]Meld, error) {
+	if err := rules.validate(); err != nil {
+		return nil, err
+	}
+	held, err := cardSet(hand.Cards())
+	if err != nil {
+		return nil, err
+	}
+	return melds(held, rules), nil
+}
+
+//CanLayOff returns true if the card can be added to the meld keeping it a set or a run.
+func CanLayOff(card cards.Card, meld Meld, rules Rules) bool {
+	for _, melded := range meld.Cards {
+		if melded.Matches(&card) {
+			return false
+		}
+	}
+	extended, ok := newMeld(append(append([]cards.Card{}, meld.Cards...), card), rules)
+	return ok && extended.Kind == meld.Kind
+}
+
+//meldSuits are the suits runs are made in.
+var meldSuits = []cards.SuitName{cards.Clubs, cards.Diamonds, cards.Hearts, cards.Spades}
+
+//cardSet returns the set of the cards.
+//
+//Errors if a card is a joker or repeated.
+func cardSet(cs []cards.Card) (cards.CardSet, error) {
+	set := cards.NewCardSet(cs...)
+	if set.Count() != len(cs) {
+		return 0, &InvalidHand{}
+	}
+	for _, card := range cs {
+		if card.Index() < 0 || card.Index() >= 52 {
+			return 0, &InvalidHand{}
+		}
+	}
+	return set, nil
+}
+
+//melds returns every set and run in the cards held.
+func melds(held cards.CardSet, rules Rules) []Meld {
+	found := []Meld{}
+	var ranks [][]cards.Card
+	for _, card := range held.Cards() {
+		grouped := false
+		for i := range ranks {
+			if ranks[i][0].MatchesRank(&card) {
+				ranks[i] = append(ranks[i], card)
+				grouped = true
+				break
+			}
+		}
+		if !grouped {
+			ranks = append(ranks, []cards.Card{card})
+		}
+	}
+	for _, same := range ranks {
+		//Every three or more of the cards of a rank are a set.
+		for chosen := 1; chosen < 1<<uint(len(same)); chosen++ {
+			set := []cards.Card{}
+			for i, card := range same {
+				if chosen&(1<<uint(i)) != 0 {
+					set = append(set, card)
+				}
+			}
+			if len(set) >= 3 {
+				found = append(found, Meld{Kind: Set, Cards: set})
+			}
+		}
+	}
+	seen := map[cards.CardSet]bool{}
+	for _, suit := range meldSuits {
+		for _, line := range rules.lines() {
+			for start := range line {
+				run := []cards.Card{}
+				for _, rank := range line[start:] {
+					card, _ := cards.NewCard(rank, suit)
+					if !held.Contains(card) || len(run) == 13 {
+						break
+					}
+					run = append(run, card)
+					if set := cards.NewCardSet(run...); len(run) >= 3 && !seen[set] {
+						seen[set] = true
+						found = append(found, Meld{Kind: Run, Cards: append([]cards.Card{}, run...)})
+					}
+				}
+			}
+		}
+	}
+	return found
+}
+
+//newMeld returns the set or run made of the cards, ok is false if they are neither.
+func newMeld(cs []cards.Card, rules Rules) (Meld, bool) {
+	if len(cs) < 3 {
+		return Meld{}, false
+	}
+	set := true
+	for _, card := range cs[1:] {
+		if !card.MatchesRank(&cs[0]) {
+			set = false
+		}
+	}
+	if set && len(cs) <= 4 {
+		return Meld{Kind: Set, Cards: append([]cards.Card{}, cs...)}, true
+	}
+	if run, ok := runOrder(cs, rules); ok {
+		return Meld{Kind: Run, Cards: run}, true
+	}
+	return Meld{}, false
+}
+
+//runOrder returns the cards in order from the lowest if they are a run, ok is false otherwise.
+func runOrder(cs []cards.Card, rules Rules) ([]cards.Card, bool) {
+	suit := cs[0].Suit().Name()
+	held := map[cards.Rank]bool{}
+	for _, card := range cs {
+		if card.Suit().Name() != suit || card.Index() < 0 || card.Index() >= 52 || held[card.Rank()] {
+			return nil, false
+		}
+		held[card.Rank()] = true
+	}
+	if len(cs) > 13 {
+		return nil, false
+	}
+	for _, line := range rules.lines() {
+		for start := 0; start+len(cs) <= len(line); start++ {
+			run := make([]cards.Card, 0, len(cs))
+			for _, rank := range line[start : start+len(cs)] {
+				if !held[rank] {
+					break
+				}
+				card, _ := cards.NewCard(rank, suit)
+				run = append(run, card)
+			}
+			if len(run) == len(cs) {
+				return run, true
+			}
+		}
+	}
+	return nil, false
+}
+
+//runStarts returns the positions in each line of the rules where the ranks of the run are in a row.
+func runStarts(run Meld, rules Rules) [][]int {
+	ranks := map[cards.Rank]bool{}
+	for _, card := range run.Cards {
+		ranks[card.Rank()] = true
+	}
+	lines := rules.lines()
+	starts := make([][]int, len(lines))
+	for i, line := range lines {
+		for start := 0; start+len(run.Cards) <= len(line); start++ {
+			matches := true
+			for _, rank := range line[start : start+len(run.Cards)] {
+				if !ranks[rank] {
+					matches = false
+					break
+				}
+			}
+			if matches {
+				starts[i] = append(starts[i], start)
+			}
+		}
+	}
+	return starts
+}
@@ -0,0 +1,121 @@
+package rummy
+
+import (
+	"testing"
+
+	"github.com/anthonyrouseau/games/cards"
+	"github.com/stretchr/testify/assert"
+)
+
+func mustParse(notation string) []cards.Card {
+	cs, err := cards.ParseCards(notation)
+	if err != nil {
+		panic(err)
+	}
+	return cs
+}
+
+//hand returns a hand holding the cards written in notation.
+func hand(notation string) *cards.Hand {
+	cs := mustParse(notation)
+	pile, _ := cards.NewPile(len(cs), cs...)
+	return &cards.Hand{Pile: pile}
+}
+
+//meld returns the meld of the cards written in notation.
+func meld(notation string) Meld {
+	m, err := NewMeld(mustParse(notation), DefaultRules())
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+func TestMelds(t *testing.T) {
+	assert := assert.New(t)
+	rules := DefaultRules()
+	tests := []struct {
+		hand  string
+		aces  AceRule
+		melds []string
+	}{
+		{"7h 7d 7s 8h 9h 5c", AceLow, []string{"7s 7d 7h", "7h 8h 9h"}},
+		{"7c 7d 7h 7s", AceLow, []string{"7c 7s 7d", "7c 7s 7h", "7c 7d 7h", "7s 7d 7h", "7c 7s 7d 7h"}},
+		{"4c 5c 6c 7c", AceLow, []string{"4c 5c 6c", "4c 5c 6c 7c", "5c 6c 7c"}},
+		{"Ah 2h 3h Qh Kh", AceLow, []string{"Ah 2h 3h"}},
+		{"Ah 2h 3h Qh Kh", AceHigh, []string{"Qh Kh Ah"}},
+		{"Ah 2h 3h Qh Kh", AceLowOrHigh, []string{"Ah 2h 3h", "Qh Kh Ah"}},
+		{"Ah 2h 3h Qh Kh", AceAround, []string{"Ah 2h 3h", "Qh Kh Ah", "Qh Kh Ah 2h", "Qh Kh Ah 2h 3h", "Kh Ah 2h", "Kh Ah 2h 3h"}},
+		{"2c 2d 3h 4s Kc", AceLow, []string{}},
+	}
+	for _, test := range tests {
+		rules.Aces = test.aces
+		melds, err := Melds(hand(test.hand), rules)
+		assert.NoError(err)
+		found := make([][]cards.Card, len(melds))
+		for i, m := range melds {
+			found[i] = m.Cards
+		}
+		want := make([][]cards.Card, len(test.melds))
+		for i, notation := range test.melds {
+			want[i] = mustParse(notation)
+		}
+		assert.ElementsMatch(want, found, test.hand)
+	}
+
+	_, err := Melds(hand("7c 7c 7d"), rules)
+	assert.IsType(&InvalidHand{}, err)
+	_, err = Melds(hand("7c JK 7d"), rules)
+	assert.IsType(&InvalidHand{}, err)
+	_, err = Melds(hand("7c 7h 7d"), Rules{Aces: AceRule(4)})
+	assert.IsType(&InvalidRules{}, err)
+}
+
+func TestNewMeld(t *testing.T) {
+	assert := assert.New(t)
+	m, err := NewMeld(mustParse("5h 7h 6h"), DefaultRules())
+	assert.NoError(err)
+	assert.Equal(Meld{Kind: Run, Cards: mustParse("5h 6h 7h")}, m)
+	m, err = NewMeld(mustParse("5h 5c 5d"), DefaultRules())
+	assert.NoError(err)
+	assert.Equal(Set, m.Kind)
+
+	for _, notation := range []string{"5h 6h", "5h 6h 8h", "5h 6c 7h", "Kh Ah 2h", "5h 5c 5d 6d"} {
+		_, err = NewMeld(mustParse(notation), DefaultRules())
+		assert.IsType(&InvalidMeld{}, err, notation)
+	}
+	_, err = NewMeld(mustParse("5h 5h 5d"), DefaultRules())
+	assert.IsType(&InvalidHand{}, err)
+}
+
+func TestCanLayOff(t *testing.T) {
+	assert := assert.New(t)
+	rules := DefaultRules()
+	tests := []struct {
+		card string
+		kind MeldKind
+		meld string
+		aces AceRule
+		ok   bool
+	}{
+		{"7c", Set, "7s 7d 7h", AceLow, true},
+		{"7h", Set, "7s 7d 7h", AceLow, false},
+		{"8c", Set, "7s 7d 7h", AceLow, false},
+		{"4h", Run, "5h 6h 7h", AceLow, true},
+		{"8h", Run, "5h 6h 7h", AceLow, true},
+		{"9h", Run, "5h 6h 7h", AceLow, false},
+		{"8d", Run, "5h 6h 7h", AceLow, false},
+		{"Ah", Run, "2h 3h 4h", AceLow, true},
+		{"Ah", Run, "Jh Qh Kh", AceLow, false},
+		{"Ah", Run, "Jh Qh Kh", AceHigh, true},
+		{"Ah", Run, "2h 3h 4h", AceHigh, false},
+		{"Ah", Run, "Jh Qh Kh", AceLowOrHigh, true},
+		{"2h", Run, "Qh Kh Ah", AceLowOrHigh, false},
+		{"2h", Run, "Qh Kh Ah", AceAround, true},
+	}
+	for _, test := range tests {
+		rules.Aces = test.aces
+		m := Meld{Kind: test.kind, Cards: mustParse(test.meld)}
+		assert.Equal(test.ok, CanLayOff(mustParse(test.card)[0], m, rules), "%s on %s", test.card, test.meld)
+	}
+}
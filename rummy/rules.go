@@ -0,0 +1,114 @@
+package rummy
+
+import (
+	"github.com/anthonyrouseau/games/cards"
+)
+
+//AceRule is where an Ace may go in a run.
+type AceRule int
+
+//AceRule values
+const (
+	//AceLow only allows runs like A-2-3.
+	AceLow AceRule = iota
+	//AceHigh only allows runs like Q-K-A.
+	AceHigh
+	//AceLowOrHigh allows A-2-3 and Q-K-A but not K-A-2.
+	AceLowOrHigh
+	//AceAround also allows runs turning the corner like K-A-2.
+	AceAround
+)
+
+var aceRuleNames = map[AceRule]string{
+	AceLow:       "AceLow",
+	AceHigh:      "AceHigh",
+	AceLowOrHigh: "AceLowOrHigh",
+	AceAround:    "AceAround",
+}
+
+//String returns the name of the rule.
+func (a AceRule) String() string {
+	if name, ok := aceRuleNames[a]; ok {
+		return name
+	}
+	return "Unknown"
+}
+
+//Rules are the rules for melds, deadwood and scoring a knock.
+type Rules struct {
+	//Aces is where an Ace may go in a run.
+	Aces AceRule
+	//AcePoints is the deadwood of an Ace, face cards count 10 and the other cards their rank.
+	AcePoints int
+	//KnockLimit is the most deadwood a player may knock with.
+	KnockLimit int
+	//GinBonus is scored for knocking with no deadwood.
+	GinBonus int
+	//BigGinBonus replaces the GinBonus when the knocker melds every card without discarding.
+	BigGinBonus int
+	//UndercutBonus is scored by a defender with no more deadwood than the knocker.
+	UndercutBonus int
+}
+
+//DefaultRules returns the rules of Gin Rummy.
+//
+//Aces are low and count 1, players knock with 10 or less, gin scores 25, big gin 31 and an undercut 25.
+func DefaultRules() Rules {
+	return Rules{
+		Aces:          AceLow,
+		AcePoints:     1,
+		KnockLimit:    10,
+		GinBonus:      25,
+		BigGinBonus:   31,
+		UndercutBonus: 25,
+	}
+}
+
+//validate returns an error for the first rule that can not be used.
+func (r Rules) validate() error {
+	switch {
+	case r.Aces < AceLow || r.Aces > AceAround:
+		return &InvalidRules{rule: "Aces"}
+	case r.AcePoints < 0:
+		return &InvalidRules{rule: "AcePoints"}
+	case r.KnockLimit < 0:
+		return &InvalidRules{rule: "KnockLimit"}
+	case r.GinBonus < 0:
+		return &InvalidRules{rule: "GinBonus"}
+	case r.BigGinBonus < 0:
+		return &InvalidRules{rule: "BigGinBonus"}
+	case r.UndercutBonus < 0:
+		return &InvalidRules{rule: "UndercutBonus"}
+	}
+	return nil
+}
+
+//Value returns the deadwood points of a card.
+func (r Rules) Value(card cards.Card) int {
+	switch {
+	case card.Rank() == cards.Ace:
+		return r.AcePoints
+	case card.Rank() >= cards.Ten:
+		return 10
+	}
+	return int(card.Rank())
+}
+
+//lines returns the orders of ranks runs are taken from.
+//
+//A run is any three or more ranks in a row of one of the lines,
+//those turning the corner are in a line going around twice.
+func (r Rules) lines() [][]cards.Rank {
+	low := []cards.Rank{cards.Ace, cards.Two, cards.Three, cards.Four, cards.Five, cards.Six, cards.Seven,
+		cards.Eight, cards.Nine, cards.Ten, cards.Jack, cards.Queen, cards.King}
+	high := append(append([]cards.Rank{}, low[1:]...), cards.Ace)
+	switch r.Aces {
+	case AceHigh:
+		return [][]cards.Rank{high}
+	case AceLowOrHigh:
+		return [][]cards.Rank{low, high}
+	case AceAround:
+		return [][]cards.Rank{append(append([]cards.Rank{}, low...), low...)}
+	}
+	return [][]cards.Rank{low}
+}
@@ -0,0 +1,61 @@
+package solitaire
+
+import (
+	"fmt"
+)
+
+//InvalidMove signals a move the rules do not allow.
+//
+//e.g. placing a red Seven on a red Eight in Klondike.
+type InvalidMove struct {
+	move Move
+}
+
+func (e *InvalidMove) Error() string {
+	return fmt.Sprintf("Move %v is not allowed.", e.move)
+}
+
+//NothingToUndo signals an undo before any move was made.
+type NothingToUndo struct{}
+
+func (e *NothingToUndo) Error() string {
+	return "There are no moves to undo."
+}
+
+//InvalidDeck signals a deck that can not be dealt for the game.
+//
+//e.g. a deck holding jokers for Klondike.
+type InvalidDeck struct {
+	game string
+}
+
+func (e *InvalidDeck) Error() string {
+	return fmt.Sprintf("The deck can not be dealt for %s.", e.game)
+}
+
+//InvalidOption signals an option the game does not support.
+//
+//e.g. drawing 2 cards at a time in Klondike.
+type InvalidOption struct {
+	option string
+}
+
+func (e *InvalidOption) Error() string {
+	return fmt.Sprintf("Option %s is not valid.", e.option)
+}
+
+//Unsolvable signals a layout that can not be won.
+type Unsolvable struct{}
+
+func (e *Unsolvable) Error() string {
+	return "The layout can not be won."
+}
+
+//SearchLimit signals a solver stopping before finding out whether a layout can be won.
+type SearchLimit struct {
+	states int
+}
+
+func (e *SearchLimit) Error() string {
+	return fmt.Sprintf("No solution was found within %d positions.", e.states)
+}
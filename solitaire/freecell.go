@@ -0,0 +1,116 @@
+package solitaire
+
+import (
+	"github.com/anthonyrouseau/games/cards"
+)
+
+//freecell is the rules of FreeCell.
+type freecell struct{}
+
+//NewFreeCell deals the numbered game of FreeCell from Microsoft's deal numbers.
+//
+//The cards are dealt by the same generator as Microsoft FreeCell so game 1 has the same layout
+//e.g. the first row of game 1 is JD 2D 9H JC 5D 7H 7C 5H.
+//Errors if deal is less than 1.
+func NewFreeCell(deal int) (*Game, error) {
+	if deal < 1 {
+		return nil, &InvalidOption{option: "deal"}
+	}
+	pile, _ := cards.NewPile(52, microsoftDeal(deal)...)
+	deck := cards.Deck{Pile: pile}
+	return NewFreeCellFromDeck(&deck)
+}
+
+//NewFreeCellFromDeck deals a game of FreeCell from the top of the deck as it is.
+//
+//Every card is dealt face up in rows from left to right across eight tableau piles,
+//the first four piles getting seven cards and the rest six. There are four empty cells.
+//Errors if the deck does not hold exactly the 52 standard cards.
+func NewFreeCellFromDeck(deck *cards.Deck) (*Game, error) {
+	if !isStandardDeck(deck.Cards(), 1) {
+		return nil, &InvalidDeck{game: "FreeCell"}
+	}
+	g := newGame(freecell{}, 52, map[PileKind]int{Foundation: 4, Tableau: 8, Cell: 4})
+	tableau := g.piles[Tableau]
+	for i := 0; deck.CardCount() > 0; i++ {
+		card, _ := deck.PickTop()
+		tableau[i%len(tableau)].PlaceTop(card)
+	}
+	return g, nil
+}
+
+//microsoftDeal returns the cards of a Microsoft FreeCell deal in the order they are dealt.
+func microsoftDeal(deal int) []cards.Card {
+	//The generator numbers cards by rank from the Ace then suit in this order.
+	suits := []cards.SuitName{cards.Clubs, cards.Diamonds, cards.Hearts, cards.Spades}
+	remaining := make([]cards.Card, 52)
+	for i := range remaining {
+		remaining[i], _ = cards.NewCard(cards.Rank(i/4+1), suits[i%4])
+	}
+	seed := uint32(deal)
+	dealt := make([]cards.Card, 0, 52)
+	for left := 52; left > 0; left-- {
+		seed = (seed*214013 + 2531011) & 0x7fffffff
+		i := int(seed>>16) % left
+		dealt = append(dealt, remaining[i])
+		remaining[i] = remaining[left-1]
+	}
+	return dealt
+}
+
+func (f freecell) name() string {
+	return "FreeCell"
+}
+
+//canPick allows single cards from cells and runs of alternating colors from the tableau
+//no longer than the most cards that can be moved using the empty cells and tableau piles.
+func (f freecell) canPick(g *Game, from Location, count int) bool {
+	switch from.Kind {
+	case Cell:
+		return count == 1
+	case Tableau:
+		return count <= g.supermove(false) && isRun(g.Pile(from).top(count), false)
+	}
+	return false
+}
+
+//canPlace allows single cards onto the foundations and the first empty cell, cards onto the next rank up
+//of the other color in the tableau and any cards into empty tableau piles.
+func (f freecell) canPlace(g *Game, m Move, bottom cards.Card) bool {
+	to := g.Pile(m.To)
+	switch to.kind {
+	case Foundation:
+		return m.Count == 1 && g.canBuildFoundation(bottom, m.To)
+	case Cell:
+		//Every empty cell is the same so only the first is used.
+		return m.Count == 1 && m.From.Kind != Cell && to.CardCount() == 0 && m.To.Index == g.firstEmpty(Cell)
+	case Tableau:
+		top, err := to.PeekTop()
+		if err != nil {
+			//Moving a whole pile to an empty pile changes nothing.
+			return m.Count <= g.supermove(true) && !(m.From.Kind == Tableau && m.Count == g.Pile(m.From).CardCount())
+		}
+		return m.Count <= g.supermove(false) && follows(bottom, top, false)
+	}
+	return false
+}
+
+func (f freecell) stockMoves(g *Game) []Move {
+	return nil
+}
+
+func (f freecell) playStock(g *Game, m Move) {}
+
+func (f freecell) settle(g *Game) {}
+
+//supermove returns the most cards that can be moved together one at a time through the empty cells and tableau piles.
+//
+//Each empty cell adds a card and each empty tableau pile doubles the count,
+//a pile the cards are moving into can not also hold them on the way.
+func (g *Game) supermove(toEmpty bool) int {
+	columns := g.empty(Tableau)
+	if toEmpty {
+		columns--
+	}
+	return (1 + g.empty(Cell)) << uint(columns)
+}
@@ -0,0 +1,132 @@
+package solitaire
+
+import (
+	"testing"
+
+	"github.com/anthonyrouseau/games/cards"
+	"github.com/stretchr/testify/assert"
+)
+
+//emptyFreeCell returns a FreeCell game with every pile empty.
+func emptyFreeCell() *Game {
+	return newGame(freecell{}, 52, map[PileKind]int{Foundation: 4, Tableau: 8, Cell: 4})
+}
+
+func TestNewFreeCell(t *testing.T) {
+	assert := assert.New(t)
+	g, err := NewFreeCell(1)
+	assert.NoError(err)
+	assert.Equal("FreeCell", g.Name())
+	//Microsoft game 1 lists its columns from the first card dealt.
+	assert.Equal(mustParse("6s 6d 3s 4c 2s Kd Jd"), g.Pile(Location{Tableau, 0}).Cards())
+	assert.Equal(mustParse("Tc 7d 7s 3c 3h 5h"), g.Pile(Location{Tableau, 7}).Cards())
+	for i, pile := range g.Piles(Tableau) {
+		assert.Equal(0, pile.FaceDown())
+		assert.Equal(7-i/4, pile.CardCount())
+	}
+	assert.Len(g.Piles(Cell), 4)
+
+	_, err = NewFreeCell(0)
+	assert.IsType(&InvalidOption{}, err)
+	jokers := cards.NewStandardDeck(true)
+	_, err = NewFreeCellFromDeck(&jokers)
+	assert.IsType(&InvalidDeck{}, err)
+}
+
+func TestFreeCellMoves(t *testing.T) {
+	assert := assert.New(t)
+	g := emptyFreeCell()
+	setPile(g, Location{Tableau, 0}, "5h 6s 7d 8c 9h", 0)
+	setPile(g, Location{Tableau, 1}, "Tc", 0)
+	setPile(g, Location{Tableau, 2}, "Ac", 0)
+	for i := 3; i < 8; i++ {
+		setPile(g, Location{Tableau, i}, "Kh", 0)
+	}
+	for i := 4; i < 8; i++ {
+		setPile(g, Location{Tableau, i}, "", 0)
+	}
+	setPile(g, Location{Cell, 0}, "Qs", 0)
+	setPile(g, Location{Cell, 1}, "Qd", 0)
+	setPile(g, Location{Cell, 2}, "Jd", 0)
+
+	tests := []struct {
+		cells, columns int
+		toEmpty        bool
+		expected       int
+	}{
+		{1, 4, false, 32},
+		{1, 4, true, 16},
+		{0, 0, false, 1},
+		{3, 1, true, 4},
+	}
+	for _, test := range tests {
+		layout := emptyFreeCell()
+		for i := test.cells; i < 4; i++ {
+			setPile(layout, Location{Cell, i}, "As", 0)
+		}
+		for i := test.columns; i < 8; i++ {
+			setPile(layout, Location{Tableau, i}, "As", 0)
+		}
+		assert.Equal(test.expected, layout.supermove(test.toEmpty))
+	}
+
+	assert.NoError(g.Move(Move{From: Location{Tableau, 0}, To: Location{Tableau, 1}, Count: 5}))
+	assert.Equal(0, g.Pile(Location{Tableau, 0}).CardCount())
+	assert.NoError(g.Undo())
+	//With one empty cell and four empty piles 16 cards can move into an empty pile.
+	assert.NoError(g.Move(Move{From: Location{Tableau, 0}, To: Location{Tableau, 4}, Count: 4}))
+	assert.IsType(&InvalidMove{}, g.Move(Move{From: Location{Tableau, 4}, To: Location{Tableau, 5}, Count: 4}))
+	assert.IsType(&InvalidMove{}, g.Move(Move{From: Location{Tableau, 0}, To: Location{Cell, 0}, Count: 1}))
+	assert.IsType(&InvalidMove{}, g.Move(Move{From: Location{Tableau, 0}, To: Location{Cell, 1}, Count: 1}))
+	assert.NoError(g.Move(Move{From: Location{Tableau, 0}, To: Location{Cell, 3}, Count: 1}))
+	assert.IsType(&InvalidMove{}, g.Move(Move{From: Location{Cell, 2}, To: Location{Tableau, 6}, Count: 2}))
+	assert.NoError(g.Move(Move{From: Location{Cell, 2}, To: Location{Tableau, 6}, Count: 1}))
+	assert.IsType(&InvalidMove{}, g.Move(Move{From: Location{Tableau, 2}, To: Location{Foundation, 1}, Count: 1}))
+	assert.NoError(g.Move(Move{From: Location{Tableau, 2}, To: Location{Foundation, 0}, Count: 1}))
+	assert.IsType(&InvalidMove{}, g.Move(Move{From: Location{Foundation, 0}, To: Location{Tableau, 2}, Count: 1}))
+
+	for _, m := range g.Moves() {
+		assert.True(g.canMove(m), m.String())
+	}
+	for g.MoveCount() > 0 {
+		assert.NoError(g.Undo())
+	}
+	assert.Equal(mustParse("5h 6s 7d 8c 9h"), g.Pile(Location{Tableau, 0}).Cards())
+	assert.Equal(mustParse("Jd"), g.Pile(Location{Cell, 2}).Cards())
+}
+
+func TestFreeCellAutoPlay(t *testing.T) {
+	assert := assert.New(t)
+	g := emptyFreeCell()
+	setPile(g, Location{Foundation, 0}, "Ac", 0)
+	setPile(g, Location{Foundation, 1}, "Ad", 0)
+	setPile(g, Location{Foundation, 2}, "Ah", 0)
+	setPile(g, Location{Foundation, 3}, "2s As", 0)
+	setPile(g, Location{Tableau, 0}, "2c 2d 3d", 0)
+	setPile(g, Location{Cell, 0}, "3c", 0)
+	played := g.AutoPlay()
+	assert.Len(played, 3)
+	//The Three of Clubs waits for the Two of Hearts.
+	assert.Equal(mustParse("3c"), g.Pile(Location{Cell, 0}).Cards())
+	assert.Equal(mustParse("3d 2d Ad"), g.Pile(Location{Foundation, 1}).Cards())
+}
+
+func TestSolveFreeCell(t *testing.T) {
+	assert := assert.New(t)
+	g := emptyFreeCell()
+	setPile(g, Location{Foundation, 0}, "Tc 9c 8c 7c 6c 5c 4c 3c 2c Ac", 0)
+	setPile(g, Location{Foundation, 1}, "Td 9d 8d 7d 6d 5d 4d 3d 2d Ad", 0)
+	setPile(g, Location{Foundation, 2}, "Th 9h 8h 7h 6h 5h 4h 3h 2h Ah", 0)
+	setPile(g, Location{Foundation, 3}, "Ts 9s 8s 7s 6s 5s 4s 3s 2s As", 0)
+	setPile(g, Location{Tableau, 0}, "Jc Qh Kc Jd", 0)
+	setPile(g, Location{Tableau, 1}, "Js Qs Kd Qc", 0)
+	setPile(g, Location{Tableau, 2}, "Jh Qd Kh Ks", 0)
+	before := g.key()
+	solution, err := Solve(g, 0)
+	assert.NoError(err)
+	assert.Equal(before, g.key())
+	for _, m := range solution {
+		assert.NoError(g.Move(m))
+	}
+	assert.True(g.Won())
+}
@@ -0,0 +1,335 @@
+package solitaire
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/anthonyrouseau/games/cards"
+)
+
+//rules are the parts of a solitaire game that differ between variants.
+type rules interface {
+	//name returns the name of the game.
+	name() string
+	//canPick returns true if the top count face up cards of a pile, other than the stock,
+	//can be moved together. Picking more cards is never allowed once fewer are not.
+	canPick(g *Game, from Location, count int) bool
+	//canPlace returns true if the cards of a move, with the given bottom card, can be placed on its destination.
+	canPlace(g *Game, m Move, bottom cards.Card) bool
+	//stockMoves returns the allowed moves using the stock.
+	stockMoves(g *Game) []Move
+	//playStock makes a move returned by stockMoves.
+	playStock(g *Game, m Move)
+	//settle tidies the layout after each move e.g. clearing completed runs.
+	settle(g *Game)
+}
+
+//Game is a game of solitaire.
+//
+//Every variant shares the same layout of piles, move validation, undo, auto-play and solver,
+//only the rules for moving cards differ.
+type Game struct {
+	rules   rules
+	piles   map[PileKind][]*Pile
+	order   []*Pile
+	history []snapshot
+}
+
+//snapshot holds the piles a move changed as they were before it.
+type snapshot struct {
+	piles    []*Pile
+	cards    [][]cards.Card
+	faceDown []int
+}
+
+//newGame returns a game with the given number of each kind of pile.
+func newGame(r rules, maxSize int, counts map[PileKind]int) *Game {
+	g := &Game{rules: r, piles: map[PileKind][]*Pile{}}
+	for _, kind := range []PileKind{Stock, Waste, Foundation, Tableau, Cell} {
+		for i := 0; i < counts[kind]; i++ {
+			pile := newPile(kind, maxSize)
+			g.piles[kind] = append(g.piles[kind], pile)
+			g.order = append(g.order, pile)
+		}
+	}
+	return g
+}
+
+//Name returns the name of the game e.g. "Klondike".
+func (g *Game) Name() string {
+	return g.rules.name()
+}
+
+//Piles returns the piles of a kind in index order.
+func (g *Game) Piles(kind PileKind) []*Pile {
+	return append([]*Pile{}, g.piles[kind]...)
+}
+
+//Pile returns the pile at a location, nil if there is none.
+func (g *Game) Pile(l Location) *Pile {
+	piles := g.piles[l.Kind]
+	if l.Index < 0 || l.Index >= len(piles) {
+		return nil
+	}
+	return piles[l.Index]
+}
+
+//Moves returns every move allowed in the current layout.
+func (g *Game) Moves() []Move {
+	moves := g.rules.stockMoves(g)
+	for _, fromKind := range []PileKind{Waste, Cell, Tableau, Foundation} {
+		for i, from := range g.piles[fromKind] {
+			faceUp := from.FaceUp()
+			for count := 1; count <= len(faceUp) && g.rules.canPick(g, Location{fromKind, i}, count); count++ {
+				for _, toKind := range []PileKind{Foundation, Tableau, Cell} {
+					for j := range g.piles[toKind] {
+						m := Move{From: Location{fromKind, i}, To: Location{toKind, j}, Count: count}
+						if m.From != m.To && g.rules.canPlace(g, m, faceUp[count-1]) {
+							moves = append(moves, m)
+						}
+					}
+				}
+			}
+		}
+	}
+	return moves
+}
+
+//Move makes a move that can be undone.
+//
+//Errors if the move is not allowed.
+func (g *Game) Move(m Move) error {
+	if m.From.Kind == Stock || m.To.Kind == Stock {
+		for _, allowed := range g.rules.stockMoves(g) {
+			if allowed == m {
+				g.history = append(g.history, snapshot{})
+				g.rules.playStock(g, m)
+				g.settle()
+				return nil
+			}
+		}
+		return &InvalidMove{move: m}
+	}
+	if !g.canMove(m) {
+		return &InvalidMove{move: m}
+	}
+	g.history = append(g.history, snapshot{})
+	g.transfer(g.Pile(m.From), g.Pile(m.To), m.Count)
+	g.settle()
+	return nil
+}
+
+//Undo takes back the last move.
+//
+//Errors if no moves have been made.
+func (g *Game) Undo() error {
+	if len(g.history) == 0 {
+		return &NothingToUndo{}
+	}
+	last := g.history[len(g.history)-1]
+	g.history = g.history[:len(g.history)-1]
+	for i, pile := range last.piles {
+		pile.setCards(last.cards[i], last.faceDown[i])
+	}
+	return nil
+}
+
+//canMove returns true if a move between two piles, not using the stock, is allowed.
+func (g *Game) canMove(m Move) bool {
+	from, to := g.Pile(m.From), g.Pile(m.To)
+	if from == nil || to == nil || m.From == m.To || m.Count < 1 || m.Count > from.CardCount()-from.faceDown {
+		return false
+	}
+	bottom := from.top(m.Count)[m.Count-1]
+	return g.rules.canPick(g, m.From, m.Count) && g.rules.canPlace(g, m, bottom)
+}
+
+//MoveCount returns the number of moves made that can be undone.
+func (g *Game) MoveCount() int {
+	return len(g.history)
+}
+
+//Won returns true once every card has reached the foundations.
+func (g *Game) Won() bool {
+	for _, pile := range g.order {
+		if pile.kind != Foundation && pile.CardCount() > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+//AutoPlay moves cards to the foundations while it is safe and returns the moves made.
+//
+//A card is safe to play when it is an Ace or Two, or when both foundations of the
+//other color are built up to at least one rank below it so no card could still need it.
+//Completed runs in Spider move to the foundations on their own so AutoPlay makes no moves.
+func (g *Game) AutoPlay() []Move {
+	played := []Move{}
+	for {
+		m, ok := g.safeMove()
+		if !ok {
+			return played
+		}
+		g.Move(m)
+		played = append(played, m)
+	}
+}
+
+//safeMove returns a move of a safe card to a foundation.
+func (g *Game) safeMove() (Move, bool) {
+	for _, fromKind := range []PileKind{Waste, Cell, Tableau} {
+		for i, from := range g.piles[fromKind] {
+			card, err := from.PeekTop()
+			if err != nil || from.faceDown == from.CardCount() || !g.safe(card) {
+				continue
+			}
+			for j := range g.piles[Foundation] {
+				m := Move{From: Location{fromKind, i}, To: Location{Foundation, j}, Count: 1}
+				if g.canMove(m) {
+					return m, true
+				}
+			}
+		}
+	}
+	return Move{}, false
+}
+
+//safe returns true if no card still in play could be placed on the card.
+func (g *Game) safe(card cards.Card) bool {
+	if card.Rank() <= cards.Two {
+		return true
+	}
+	built := map[cards.Suit]cards.Rank{}
+	for _, pile := range g.piles[Foundation] {
+		if top, err := pile.PeekTop(); err == nil {
+			built[top.Suit()] = top.Rank()
+		}
+	}
+	for _, suit := range []cards.Suit{cards.ClubsSuit, cards.SpadesSuit, cards.DiamondsSuit, cards.HeartsSuit} {
+		if suit.Color() != card.Color() && built[suit] < card.Rank()-1 {
+			return false
+		}
+	}
+	return true
+}
+
+//transfer moves the top count cards of one pile onto another keeping their order.
+func (g *Game) transfer(from, to *Pile, count int) {
+	g.touch(from, to)
+	moving := from.Cards()
+	to.setCards(append(moving[:count:count], to.Cards()...), to.faceDown)
+	from.setCards(moving[count:], from.faceDown)
+}
+
+//settle lets the rules tidy up then turns up the top card of tableau piles left with only face down cards.
+func (g *Game) settle() {
+	g.rules.settle(g)
+	for _, pile := range g.piles[Tableau] {
+		if pile.faceDown > 0 && pile.faceDown == pile.CardCount() {
+			g.touch(pile)
+			pile.faceDown--
+		}
+	}
+}
+
+//touch records piles about to be changed by the current move so it can be undone.
+//
+//Every change to a pile during a move must be preceded by a touch.
+func (g *Game) touch(piles ...*Pile) {
+	last := &g.history[len(g.history)-1]
+	for _, pile := range piles {
+		touched := false
+		for _, other := range last.piles {
+			touched = touched || other == pile
+		}
+		if !touched {
+			last.piles = append(last.piles, pile)
+			last.cards = append(last.cards, pile.Cards())
+			last.faceDown = append(last.faceDown, pile.faceDown)
+		}
+	}
+}
+
+//key returns a string identifying the layout.
+//
+//Tableau, foundation and cell piles are sorted since swapping two of them does not change the game.
+func (g *Game) key() string {
+	var b strings.Builder
+	for _, kind := range []PileKind{Stock, Waste, Foundation, Tableau, Cell} {
+		keys := make([]string, len(g.piles[kind]))
+		for i, pile := range g.piles[kind] {
+			keys[i] = pile.key()
+		}
+		if kind != Stock && kind != Waste {
+			sort.Strings(keys)
+		}
+		//Card indices and face down counts are below the separators.
+		b.WriteString(strings.Join(keys, "\xfe"))
+		b.WriteByte(0xff)
+	}
+	return b.String()
+}
+
+//empty returns the number of empty piles of a kind.
+func (g *Game) empty(kind PileKind) int {
+	count := 0
+	for _, pile := range g.piles[kind] {
+		if pile.CardCount() == 0 {
+			count++
+		}
+	}
+	return count
+}
+
+//firstEmpty returns the index of the first empty pile of a kind, -1 if there is none.
+func (g *Game) firstEmpty(kind PileKind) int {
+	for i, pile := range g.piles[kind] {
+		if pile.CardCount() == 0 {
+			return i
+		}
+	}
+	return -1
+}
+
+//indices returns the indices 0 to n-1.
+func indices(n int) []int {
+	is := make([]int, n)
+	for i := range is {
+		is[i] = i
+	}
+	return is
+}
+
+//isRun returns true if each card, from the top, is one rank below the card under it
+//and has the same suit when sameSuit is true or the other color otherwise.
+func isRun(cs []cards.Card, sameSuit bool) bool {
+	for i := 0; i+1 < len(cs); i++ {
+		if !follows(cs[i], cs[i+1], sameSuit) {
+			return false
+		}
+	}
+	return true
+}
+
+//follows returns true if card can be placed on under in a descending run.
+func follows(card, under cards.Card, sameSuit bool) bool {
+	if card.Rank() != under.Rank()-1 {
+		return false
+	}
+	if sameSuit {
+		return card.MatchesSuit(&under)
+	}
+	return !card.MatchesColor(&under)
+}
+
+//canBuildFoundation returns true if the card can be placed on the foundation building up by suit from the Ace.
+//
+//An Ace may only be placed on the first empty foundation so equivalent moves are not repeated.
+func (g *Game) canBuildFoundation(card cards.Card, to Location) bool {
+	top, err := g.Pile(to).PeekTop()
+	if err != nil {
+		return card.Rank() == cards.Ace && g.firstEmpty(Foundation) == to.Index
+	}
+	return card.MatchesSuit(&top) && card.Rank() == top.Rank()+1
+}
@@ -0,0 +1,135 @@
+package solitaire
+
+import (
+	"github.com/anthonyrouseau/games/cards"
+)
+
+//klondike is the rules of Klondike drawing draw cards at a time from the stock.
+type klondike struct {
+	draw int
+}
+
+//NewKlondike deals a game of Klondike from a shuffled standard deck drawing 1 or 3 cards at a time.
+//
+//Errors if draw is not 1 or 3.
+func NewKlondike(draw int) (*Game, error) {
+	deck := cards.NewStandardDeck(false)
+	deck.Shuffle()
+	return NewKlondikeFromDeck(&deck, draw)
+}
+
+//NewKlondikeFromDeck deals a game of Klondike from the top of the deck as it is.
+//
+//Seven tableau piles are dealt in rows from left to right, the first pile getting one card
+//and each pile one more than the last, with only the top card of each face up.
+//The rest of the deck forms the stock.
+//Errors if draw is not 1 or 3 or the deck does not hold exactly the 52 standard cards.
+func NewKlondikeFromDeck(deck *cards.Deck, draw int) (*Game, error) {
+	if draw != 1 && draw != 3 {
+		return nil, &InvalidOption{option: "draw"}
+	}
+	if !isStandardDeck(deck.Cards(), 1) {
+		return nil, &InvalidDeck{game: "Klondike"}
+	}
+	g := newGame(klondike{draw: draw}, 52, map[PileKind]int{Stock: 1, Waste: 1, Foundation: 4, Tableau: 7})
+	tableau := g.piles[Tableau]
+	for row := 0; row < len(tableau); row++ {
+		for _, pile := range tableau[row:] {
+			card, _ := deck.PickTop()
+			pile.PlaceTop(card)
+		}
+	}
+	for _, pile := range tableau {
+		pile.faceDown = pile.CardCount() - 1
+	}
+	stock := g.piles[Stock][0]
+	stock.Place(deck.Cards(), indices(deck.CardCount()))
+	deck.Pick(indices(deck.CardCount()))
+	stock.faceDown = stock.CardCount()
+	return g, nil
+}
+
+//isStandardDeck returns true if the cards are the given number of copies of the 52 standard cards.
+func isStandardDeck(cs []cards.Card, copies int) bool {
+	if len(cs) != 52*copies {
+		return false
+	}
+	counts := map[int]int{}
+	for _, card := range cs {
+		if card.Index() < 0 || card.Index() >= 52 {
+			return false
+		}
+		counts[card.Index()]++
+	}
+	for _, count := range counts {
+		if count != copies {
+			return false
+		}
+	}
+	return true
+}
+
+func (k klondike) name() string {
+	return "Klondike"
+}
+
+//canPick allows single cards from any pile and runs of alternating colors from the tableau.
+func (k klondike) canPick(g *Game, from Location, count int) bool {
+	if from.Kind != Tableau {
+		return count == 1
+	}
+	return isRun(g.Pile(from).top(count), false)
+}
+
+//canPlace allows single cards onto the foundations, cards onto the next rank up of the other color
+//in the tableau, and only Kings into empty tableau piles.
+func (k klondike) canPlace(g *Game, m Move, bottom cards.Card) bool {
+	to := g.Pile(m.To)
+	switch to.kind {
+	case Foundation:
+		return m.Count == 1 && m.From.Kind != Foundation && g.canBuildFoundation(bottom, m.To)
+	case Tableau:
+		top, err := to.PeekTop()
+		if err != nil {
+			//Moving a whole pile to an empty pile changes nothing.
+			return bottom.Rank() == cards.King && m.Count < g.Pile(m.From).CardCount()
+		}
+		return follows(bottom, top, false)
+	}
+	return false
+}
+
+//stockMoves draws from the stock to the waste, or turns the waste over once the stock is empty.
+func (k klondike) stockMoves(g *Game) []Move {
+	stock, waste := g.piles[Stock][0], g.piles[Waste][0]
+	switch {
+	case stock.CardCount() > 0:
+		count := k.draw
+		if stock.CardCount() < count {
+			count = stock.CardCount()
+		}
+		return []Move{{From: Location{Stock, 0}, To: Location{Waste, 0}, Count: count}}
+	case waste.CardCount() > 0:
+		return []Move{{From: Location{Waste, 0}, To: Location{Stock, 0}, Count: waste.CardCount()}}
+	}
+	return nil
+}
+
+//playStock turns cards from the stock onto the waste one at a time, or the whole waste back over into the stock.
+func (k klondike) playStock(g *Game, m Move) {
+	stock, waste := g.piles[Stock][0], g.piles[Waste][0]
+	g.touch(stock, waste)
+	from, to := stock, waste
+	if m.From.Kind == Waste {
+		from, to = waste, stock
+	}
+	turned := from.Cards()
+	for i := 0; i < m.Count/2; i++ {
+		turned[i], turned[m.Count-1-i] = turned[m.Count-1-i], turned[i]
+	}
+	to.setCards(append(turned[:m.Count:m.Count], to.Cards()...), 0)
+	from.setCards(turned[m.Count:], 0)
+	stock.faceDown = stock.CardCount()
+}
+
+func (k klondike) settle(g *Game) {}
@@ -0,0 +1,217 @@
+package solitaire
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/anthonyrouseau/games/cards"
+	"github.com/stretchr/testify/assert"
+)
+
+func mustParse(notation string) []cards.Card {
+	cs, err := cards.ParseCards(notation)
+	if err != nil {
+		panic(err)
+	}
+	return cs
+}
+
+//setPile replaces the cards of a pile with the cards given from top to bottom.
+func setPile(g *Game, l Location, notation string, faceDown int) {
+	pile := g.Pile(l)
+	pile.Pick(indices(pile.CardCount()))
+	cs := mustParse(notation)
+	pile.Place(cs, indices(len(cs)))
+	pile.faceDown = faceDown
+}
+
+//emptyKlondike returns a Klondike game with every pile empty.
+func emptyKlondike(draw int) *Game {
+	return newGame(klondike{draw: draw}, 52, map[PileKind]int{Stock: 1, Waste: 1, Foundation: 4, Tableau: 7})
+}
+
+func seededDeck(seed int64) *cards.Deck {
+	deck := cards.NewStandardDeck(false)
+	deck.SetSource(rand.NewSource(seed))
+	deck.Shuffle()
+	return &deck
+}
+
+func TestNewKlondike(t *testing.T) {
+	assert := assert.New(t)
+	deck := cards.NewStandardDeck(false)
+	first := deck.Cards()
+	g, err := NewKlondikeFromDeck(&deck, 3)
+	assert.NoError(err)
+	assert.Equal("Klondike", g.Name())
+	for i, pile := range g.Piles(Tableau) {
+		assert.Equal(i+1, pile.CardCount())
+		assert.Equal(i, pile.FaceDown())
+		assert.Len(pile.FaceUp(), 1)
+	}
+	assert.Equal(first[0], g.Piles(Tableau)[0].Cards()[0])
+	assert.Equal(first[1], g.Piles(Tableau)[1].Cards()[1])
+	assert.Equal(first[7], g.Piles(Tableau)[1].Cards()[0])
+	assert.Equal(24, g.Pile(Location{Stock, 0}).CardCount())
+	assert.Equal(0, deck.CardCount())
+	assert.False(g.Won())
+
+	_, err = NewKlondikeFromDeck(seededDeck(1), 2)
+	assert.IsType(&InvalidOption{}, err)
+	jokers := cards.NewStandardDeck(true)
+	_, err = NewKlondikeFromDeck(&jokers, 1)
+	assert.IsType(&InvalidDeck{}, err)
+	g, err = NewKlondike(1)
+	assert.NoError(err)
+	assert.NotNil(g)
+}
+
+func TestKlondikeStock(t *testing.T) {
+	assert := assert.New(t)
+	for _, draw := range []int{1, 3} {
+		g, _ := NewKlondikeFromDeck(seededDeck(2), draw)
+		stock := g.Pile(Location{Stock, 0})
+		waste := g.Pile(Location{Waste, 0})
+		order := stock.Cards()
+		draws := 0
+		for stock.CardCount() > 0 {
+			m := Move{From: Location{Stock, 0}, To: Location{Waste, 0}, Count: draw}
+			assert.NoError(g.Move(m))
+			draws++
+			top, _ := waste.PeekTop()
+			assert.Equal(order[draws*draw-1], top)
+		}
+		assert.Equal(24, waste.CardCount())
+		assert.Equal([]Move{{From: Location{Waste, 0}, To: Location{Stock, 0}, Count: 24}}, g.rules.stockMoves(g))
+		assert.NoError(g.Move(Move{From: Location{Waste, 0}, To: Location{Stock, 0}, Count: 24}))
+		assert.Equal(order, stock.Cards())
+		assert.Equal(24, stock.FaceDown())
+	}
+}
+
+func TestKlondikeMoves(t *testing.T) {
+	assert := assert.New(t)
+	g := emptyKlondike(1)
+	setPile(g, Location{Tableau, 0}, "7h 8c 2d", 1)
+	setPile(g, Location{Tableau, 1}, "9d 4s", 1)
+	setPile(g, Location{Tableau, 2}, "9s", 0)
+	setPile(g, Location{Tableau, 3}, "Qd Kc Jc", 1)
+	setPile(g, Location{Tableau, 4}, "Ah", 0)
+	setPile(g, Location{Waste, 0}, "8h", 0)
+	setPile(g, Location{Foundation, 0}, "2s As", 0)
+
+	assert.NoError(g.Move(Move{From: Location{Tableau, 0}, To: Location{Tableau, 1}, Count: 2}))
+	assert.Equal(mustParse("7h 8c 9d 4s"), g.Pile(Location{Tableau, 1}).Cards())
+	assert.Equal(mustParse("2d"), g.Pile(Location{Tableau, 0}).FaceUp())
+	assert.Equal(0, g.Pile(Location{Tableau, 0}).FaceDown())
+
+	assert.IsType(&InvalidMove{}, g.Move(Move{From: Location{Waste, 0}, To: Location{Tableau, 1}, Count: 1}))
+	assert.NoError(g.Move(Move{From: Location{Waste, 0}, To: Location{Tableau, 2}, Count: 1}))
+	assert.IsType(&InvalidMove{}, g.Move(Move{From: Location{Tableau, 3}, To: Location{Tableau, 5}, Count: 3}))
+	assert.NoError(g.Move(Move{From: Location{Tableau, 3}, To: Location{Tableau, 5}, Count: 2}))
+	assert.Equal(mustParse("Jc"), g.Pile(Location{Tableau, 3}).FaceUp())
+	assert.IsType(&InvalidMove{}, g.Move(Move{From: Location{Tableau, 4}, To: Location{Foundation, 2}, Count: 1}))
+	assert.NoError(g.Move(Move{From: Location{Tableau, 4}, To: Location{Foundation, 1}, Count: 1}))
+	assert.IsType(&InvalidMove{}, g.Move(Move{From: Location{Tableau, 3}, To: Location{Foundation, 0}, Count: 1}))
+
+	for _, m := range g.Moves() {
+		assert.True(g.canMove(m) || m.From.Kind == Stock || m.To.Kind == Stock, m.String())
+		assert.NotEqual(Location{Tableau, 5}, m.From, "a King alone moved to an empty pile")
+	}
+
+	assert.Equal(4, g.MoveCount())
+	for g.MoveCount() > 0 {
+		assert.NoError(g.Undo())
+	}
+	assert.Equal(mustParse("7h 8c 2d"), g.Pile(Location{Tableau, 0}).Cards())
+	assert.Equal(1, g.Pile(Location{Tableau, 0}).FaceDown())
+	assert.Equal(mustParse("8h"), g.Pile(Location{Waste, 0}).Cards())
+	assert.IsType(&NothingToUndo{}, g.Undo())
+}
+
+func TestKlondikeAutoPlay(t *testing.T) {
+	assert := assert.New(t)
+	g := emptyKlondike(1)
+	setPile(g, Location{Foundation, 0}, "2s As", 0)
+	setPile(g, Location{Foundation, 1}, "Ah", 0)
+	setPile(g, Location{Tableau, 0}, "3s 2h", 0)
+	setPile(g, Location{Tableau, 1}, "2c", 0)
+	setPile(g, Location{Waste, 0}, "Ac", 0)
+	played := g.AutoPlay()
+	assert.Len(played, 2)
+	//The Three of Spades is not safe while the red foundations are below Two.
+	assert.Equal(mustParse("3s 2h"), g.Pile(Location{Tableau, 0}).Cards())
+	assert.Equal(2, g.Pile(Location{Foundation, 2}).CardCount())
+}
+
+func TestKlondikeWon(t *testing.T) {
+	assert := assert.New(t)
+	g := emptyKlondike(1)
+	for i, suit := range []string{"c", "d", "h", "s"} {
+		notation := ""
+		for _, rank := range []string{"K", "Q", "J", "T", "9", "8", "7", "6", "5", "4", "3", "2", "A"} {
+			notation += rank + suit + " "
+		}
+		setPile(g, Location{Foundation, i}, notation, 0)
+	}
+	assert.True(g.Won())
+}
+
+func TestSolveKlondike(t *testing.T) {
+	assert := assert.New(t)
+	g := emptyKlondike(1)
+	setPile(g, Location{Foundation, 0}, "Jc Tc 9c 8c 7c 6c 5c 4c 3c 2c Ac", 0)
+	setPile(g, Location{Foundation, 1}, "Jd Td 9d 8d 7d 6d 5d 4d 3d 2d Ad", 0)
+	setPile(g, Location{Foundation, 2}, "Qh Jh Th 9h 8h 7h 6h 5h 4h 3h 2h Ah", 0)
+	setPile(g, Location{Foundation, 3}, "Js Ts 9s 8s 7s 6s 5s 4s 3s 2s As", 0)
+	setPile(g, Location{Tableau, 0}, "Ks Qd", 1)
+	setPile(g, Location{Tableau, 1}, "Kh Qs", 1)
+	setPile(g, Location{Stock, 0}, "Kc Qc Kd", 3)
+	before := g.key()
+	solution, err := Solve(g, 0)
+	assert.NoError(err)
+	assert.Equal(before, g.key())
+	for _, m := range solution {
+		assert.NoError(g.Move(m))
+	}
+	assert.True(g.Won())
+
+	_, err = Solve(g, 1)
+	assert.NoError(err)
+}
+
+func TestSolveKlondikeStuck(t *testing.T) {
+	assert := assert.New(t)
+	g := emptyKlondike(1)
+	rest := []cards.Card{}
+	tops := mustParse("Kc Ks Qc Qs Jc Js Tc")
+	used := cards.NewCardSet(tops...)
+	deck := cards.NewStandardDeck(false)
+	for _, card := range deck.Cards() {
+		if !used.Contains(card) {
+			rest = append(rest, card)
+		}
+	}
+	for i, top := range tops {
+		pile := g.Pile(Location{Tableau, i})
+		var under []cards.Card
+		if i < len(tops)-1 {
+			under, rest = rest[:7], rest[7:]
+		} else {
+			under = rest
+		}
+		pile.Place(append([]cards.Card{top}, under...), indices(len(under)+1))
+		pile.faceDown = len(under)
+	}
+	assert.Empty(g.Moves())
+	_, err := Solve(g, 0)
+	assert.IsType(&Unsolvable{}, err)
+}
+
+func TestSolveLimit(t *testing.T) {
+	assert := assert.New(t)
+	g, _ := NewKlondikeFromDeck(seededDeck(3), 3)
+	_, err := Solve(g, 10)
+	assert.IsType(&SearchLimit{}, err)
+	assert.Equal(0, g.MoveCount())
+}
@@ -0,0 +1,117 @@
+package solitaire
+
+import (
+	"fmt"
+
+	"github.com/anthonyrouseau/games/cards"
+)
+
+//PileKind is the role of a pile in a solitaire layout.
+type PileKind int
+
+//PileKind values
+const (
+	//Stock holds the cards not yet in play.
+	Stock PileKind = iota
+	//Waste holds the cards drawn from the stock.
+	Waste
+	//Foundation piles are built up by suit to win the game.
+	Foundation
+	//Tableau piles are the columns cards are played on.
+	Tableau
+	//Cell piles hold a single card each.
+	Cell
+)
+
+var pileKindNames = map[PileKind]string{
+	Stock:      "Stock",
+	Waste:      "Waste",
+	Foundation: "Foundation",
+	Tableau:    "Tableau",
+	Cell:       "Cell",
+}
+
+//String returns the name of the pile kind.
+func (k PileKind) String() string {
+	if name, ok := pileKindNames[k]; ok {
+		return name
+	}
+	return "Unknown"
+}
+
+//Pile is a solitaire pile whose bottom cards may be face down.
+//
+//The card handling methods of a Pile come from its cards.Pile, index 0 is the top card.
+type Pile struct {
+	cards.Pile
+	kind     PileKind
+	faceDown int
+}
+
+func newPile(kind PileKind, maxSize int) *Pile {
+	pile, _ := cards.NewPile(maxSize)
+	return &Pile{Pile: pile, kind: kind}
+}
+
+//Kind returns the role of the pile.
+func (p *Pile) Kind() PileKind {
+	return p.kind
+}
+
+//FaceDown returns the number of face down cards at the bottom of the pile.
+func (p *Pile) FaceDown() int {
+	return p.faceDown
+}
+
+//FaceUp returns the face up cards of the pile from top to bottom.
+func (p *Pile) FaceUp() []cards.Card {
+	return p.Cards()[:p.CardCount()-p.faceDown]
+}
+
+//setCards replaces the cards of the pile with the cards given from top to bottom.
+func (p *Pile) setCards(cs []cards.Card, faceDown int) {
+	p.Pile, _ = cards.NewPile(p.MaxSize(), cs...)
+	p.faceDown = faceDown
+}
+
+//top returns the top n cards of the pile from top to bottom.
+func (p *Pile) top(n int) []cards.Card {
+	cs, _ := p.Peek(indices(n))
+	return cs
+}
+
+//Location is a pile in a layout by kind and index.
+type Location struct {
+	Kind  PileKind
+	Index int
+}
+
+//String returns the location e.g. "Tableau 3".
+func (l Location) String() string {
+	return fmt.Sprintf("%v %d", l.Kind, l.Index)
+}
+
+//Move moves the top Count cards of one pile onto another.
+//
+//Moves from the Stock deal or draw from it and moves from the Waste to the Stock
+//turn the waste over to form a new stock, the rules of the game decide their effect.
+type Move struct {
+	From, To Location
+	Count    int
+}
+
+//String returns the move e.g. "Tableau 3 -> Foundation 0 (1)".
+func (m Move) String() string {
+	return fmt.Sprintf("%v -> %v (%d)", m.From, m.To, m.Count)
+}
+
+//key returns the indices of the cards of the pile followed by how many are face down.
+func (p *Pile) key() string {
+	cs := p.Cards()
+	b := make([]byte, len(cs)+1)
+	for i, card := range cs {
+		b[i] = byte(card.Index())
+	}
+	b[len(cs)] = byte(p.faceDown)
+	return string(b)
+}
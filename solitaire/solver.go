@@ -0,0 +1,111 @@
+package solitaire
+
+import (
+	"sort"
+)
+
+//DefaultSolveLimit is the number of positions Solve searches when given a limit below 1.
+const DefaultSolveLimit = 100000
+
+//Solve searches for a sequence of moves that wins the game from its current layout.
+//
+//The search is depth first, trying moves to the foundations and moves that turn up
+//face down cards first and never visiting a layout twice.
+//The game is left as it was. Errors with Unsolvable if the layout can not be won
+//or SearchLimit if more than limit positions would need to be searched.
+func Solve(g *Game, limit int) ([]Move, error) {
+	if limit < 1 {
+		limit = DefaultSolveLimit
+	}
+	s := solver{game: g, limit: limit, seen: map[string]bool{}}
+	if s.search() {
+		return s.solution, nil
+	}
+	if s.exhausted {
+		return nil, &SearchLimit{states: limit}
+	}
+	return nil, &Unsolvable{}
+}
+
+type solver struct {
+	game      *Game
+	limit     int
+	seen      map[string]bool
+	solution  []Move
+	exhausted bool
+}
+
+func (s *solver) search() bool {
+	if s.game.Won() {
+		return true
+	}
+	key := s.game.key()
+	if s.seen[key] {
+		return false
+	}
+	if len(s.seen) >= s.limit {
+		s.exhausted = true
+		return false
+	}
+	s.seen[key] = true
+	moves := s.game.Moves()
+	priorities := make([]int, len(moves))
+	for i, m := range moves {
+		priorities[i] = s.priority(m)
+	}
+	sort.Stable(byPriority{moves, priorities})
+	for _, m := range moves {
+		if s.game.Move(m) != nil {
+			continue
+		}
+		s.solution = append(s.solution, m)
+		if s.search() {
+			s.game.Undo()
+			return true
+		}
+		s.solution = s.solution[:len(s.solution)-1]
+		s.game.Undo()
+		if s.exhausted {
+			return false
+		}
+	}
+	return false
+}
+
+//priority orders moves so those most likely to make progress are tried first, lower first.
+func (s *solver) priority(m Move) int {
+	switch {
+	case m.To.Kind == Foundation:
+		return 0
+	case m.From.Kind == Foundation:
+		return 5
+	case m.From.Kind == Stock || m.To.Kind == Stock:
+		return 4
+	}
+	from := s.game.Pile(m.From)
+	if m.From.Kind == Tableau && from.faceDown > 0 && m.Count == from.CardCount()-from.faceDown {
+		return 1
+	}
+	if m.To.Kind == Cell {
+		return 3
+	}
+	return 2
+}
+
+type byPriority struct {
+	moves      []Move
+	priorities []int
+}
+
+func (b byPriority) Len() int {
+	return len(b.moves)
+}
+
+func (b byPriority) Less(i, j int) bool {
+	return b.priorities[i] < b.priorities[j]
+}
+
+func (b byPriority) Swap(i, j int) {
+	b.moves[i], b.moves[j] = b.moves[j], b.moves[i]
+	b.priorities[i], b.priorities[j] = b.priorities[j], b.priorities[i]
+}
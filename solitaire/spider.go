@@ -0,0 +1,150 @@
+package solitaire
+
+import (
+	"github.com/anthonyrouseau/games/cards"
+)
+
+//spiderSuits are the suits used by Spider with 1, 2 or 4 suits, the rest take the suit of the same color.
+var spiderSuits = map[int]map[cards.SuitName]cards.SuitName{
+	1: {cards.Clubs: cards.Spades, cards.Spades: cards.Spades, cards.Diamonds: cards.Spades, cards.Hearts: cards.Spades},
+	2: {cards.Clubs: cards.Spades, cards.Spades: cards.Spades, cards.Diamonds: cards.Hearts, cards.Hearts: cards.Hearts},
+	4: {cards.Clubs: cards.Clubs, cards.Spades: cards.Spades, cards.Diamonds: cards.Diamonds, cards.Hearts: cards.Hearts},
+}
+
+//spider is the rules of Spider played with suits suits.
+type spider struct {
+	suits int
+}
+
+//NewSpider deals a game of Spider with 1, 2 or 4 suits from two shuffled standard decks.
+//
+//With fewer than 4 suits the cards of the other suits are changed to Spades or,
+//for the red suits with 2 suits, Hearts.
+//Errors if suits is not 1, 2 or 4.
+func NewSpider(suits int) (*Game, error) {
+	changed, ok := spiderSuits[suits]
+	if !ok {
+		return nil, &InvalidOption{option: "suits"}
+	}
+	cs := []cards.Card{}
+	for i := 0; i < 2; i++ {
+		deck := cards.NewStandardDeck(false)
+		for _, card := range deck.Cards() {
+			card, _ = cards.NewCard(card.Rank(), changed[card.Suit().Name()])
+			cs = append(cs, card)
+		}
+	}
+	pile, _ := cards.NewPile(len(cs), cs...)
+	deck := cards.Deck{Pile: pile}
+	deck.Shuffle()
+	return NewSpiderFromDeck(&deck, suits)
+}
+
+//NewSpiderFromDeck deals a game of Spider with 1, 2 or 4 suits from the top of the deck as it is.
+//
+//Ten tableau piles are dealt in rows from left to right, the first four piles getting six cards
+//and the rest five, with only the top card of each face up. The rest of the deck forms the stock
+//which deals a face up card onto every tableau pile at a time.
+//Errors if suits is not 1, 2 or 4 or the deck does not hold the 104 cards of two standard decks
+//with their suits changed as in NewSpider.
+func NewSpiderFromDeck(deck *cards.Deck, suits int) (*Game, error) {
+	changed, ok := spiderSuits[suits]
+	if !ok {
+		return nil, &InvalidOption{option: "suits"}
+	}
+	if !isSpiderDeck(deck.Cards(), changed) {
+		return nil, &InvalidDeck{game: "Spider"}
+	}
+	g := newGame(spider{suits: suits}, 104, map[PileKind]int{Stock: 1, Foundation: 8, Tableau: 10})
+	tableau := g.piles[Tableau]
+	for i := 0; i < 54; i++ {
+		card, _ := deck.PickTop()
+		tableau[i%len(tableau)].PlaceTop(card)
+	}
+	for _, pile := range tableau {
+		pile.faceDown = pile.CardCount() - 1
+	}
+	stock := g.piles[Stock][0]
+	stock.Place(deck.Cards(), indices(deck.CardCount()))
+	deck.Pick(indices(deck.CardCount()))
+	stock.faceDown = stock.CardCount()
+	return g, nil
+}
+
+//isSpiderDeck returns true if the cards are two standard decks with their suits changed.
+func isSpiderDeck(cs []cards.Card, changed map[cards.SuitName]cards.SuitName) bool {
+	want := map[cards.Card]int{}
+	for i := 0; i < 2; i++ {
+		deck := cards.NewStandardDeck(false)
+		for _, card := range deck.Cards() {
+			card, _ = cards.NewCard(card.Rank(), changed[card.Suit().Name()])
+			want[card]++
+		}
+	}
+	if len(cs) != 104 {
+		return false
+	}
+	for _, card := range cs {
+		want[card]--
+		if want[card] < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (s spider) name() string {
+	return "Spider"
+}
+
+//canPick allows runs of the same suit from the tableau.
+func (s spider) canPick(g *Game, from Location, count int) bool {
+	return from.Kind == Tableau && isRun(g.Pile(from).top(count), true)
+}
+
+//canPlace allows cards onto the next rank up of any suit in the tableau and any cards into empty tableau piles.
+//
+//Completed runs leave the tableau on their own so cards are never placed on the foundations.
+func (s spider) canPlace(g *Game, m Move, bottom cards.Card) bool {
+	to := g.Pile(m.To)
+	if to.kind != Tableau {
+		return false
+	}
+	top, err := to.PeekTop()
+	if err != nil {
+		//Moving a whole pile to an empty pile changes nothing.
+		return m.Count < g.Pile(m.From).CardCount()
+	}
+	return bottom.Rank() == top.Rank()-1
+}
+
+//stockMoves deals a card onto every tableau pile while the stock has cards and no tableau pile is empty.
+func (s spider) stockMoves(g *Game) []Move {
+	tableau := g.piles[Tableau]
+	if g.piles[Stock][0].CardCount() < len(tableau) || g.empty(Tableau) > 0 {
+		return nil
+	}
+	return []Move{{From: Location{Stock, 0}, To: Location{Tableau, 0}, Count: len(tableau)}}
+}
+
+//playStock deals a face up card from the stock onto every tableau pile from left to right.
+func (s spider) playStock(g *Game, m Move) {
+	stock := g.piles[Stock][0]
+	g.touch(stock)
+	for _, pile := range g.piles[Tableau] {
+		g.touch(pile)
+		card, _ := stock.PickTop()
+		pile.PlaceTop(card)
+	}
+	stock.faceDown = stock.CardCount()
+}
+
+//settle moves runs of the same suit from King down to Ace to the first empty foundation.
+func (s spider) settle(g *Game) {
+	for _, pile := range g.piles[Tableau] {
+		faceUp := pile.FaceUp()
+		if len(faceUp) >= 13 && faceUp[0].Rank() == cards.Ace && isRun(faceUp[:13], true) {
+			g.transfer(pile, g.piles[Foundation][g.firstEmpty(Foundation)], 13)
+		}
+	}
+}
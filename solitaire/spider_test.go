@@ -0,0 +1,95 @@
+package solitaire
+
+import (
+	"testing"
+
+	"github.com/anthonyrouseau/games/cards"
+	"github.com/stretchr/testify/assert"
+)
+
+//emptySpider returns a Spider game with every pile empty.
+func emptySpider(suits int) *Game {
+	return newGame(spider{suits: suits}, 104, map[PileKind]int{Stock: 1, Foundation: 8, Tableau: 10})
+}
+
+func TestNewSpider(t *testing.T) {
+	assert := assert.New(t)
+	for _, suits := range []int{1, 2, 4} {
+		g, err := NewSpider(suits)
+		assert.NoError(err)
+		assert.Equal("Spider", g.Name())
+		for i, pile := range g.Piles(Tableau) {
+			expected := 5
+			if i < 4 {
+				expected = 6
+			}
+			assert.Equal(expected, pile.CardCount())
+			assert.Equal(expected-1, pile.FaceDown())
+		}
+		stock := g.Pile(Location{Stock, 0})
+		assert.Equal(50, stock.CardCount())
+		seen := map[cards.Suit]bool{}
+		for _, card := range stock.Cards() {
+			seen[card.Suit()] = true
+		}
+		assert.True(len(seen) <= suits)
+	}
+	_, err := NewSpider(3)
+	assert.IsType(&InvalidOption{}, err)
+	deck := cards.NewStandardDeck(false)
+	_, err = NewSpiderFromDeck(&deck, 4)
+	assert.IsType(&InvalidDeck{}, err)
+}
+
+func TestSpiderMoves(t *testing.T) {
+	assert := assert.New(t)
+	g := emptySpider(2)
+	setPile(g, Location{Tableau, 0}, "9s Th", 0)
+	setPile(g, Location{Tableau, 1}, "Jh", 0)
+	setPile(g, Location{Tableau, 2}, "Ts 9h", 1)
+	setPile(g, Location{Stock, 0}, "As 2s 3s 4s 5s 6s 7s 8s 9s Ts", 10)
+
+	assert.Empty(g.rules.stockMoves(g), "a tableau pile is empty")
+	assert.IsType(&InvalidMove{}, g.Move(Move{From: Location{Tableau, 0}, To: Location{Tableau, 1}, Count: 2}))
+	assert.NoError(g.Move(Move{From: Location{Tableau, 0}, To: Location{Tableau, 3}, Count: 1}))
+	assert.NoError(g.Move(Move{From: Location{Tableau, 0}, To: Location{Tableau, 1}, Count: 1}))
+	assert.NoError(g.Move(Move{From: Location{Tableau, 2}, To: Location{Tableau, 0}, Count: 1}))
+	assert.Equal(mustParse("9h"), g.Pile(Location{Tableau, 2}).FaceUp())
+	assert.IsType(&InvalidMove{}, g.Move(Move{From: Location{Tableau, 1}, To: Location{Foundation, 0}, Count: 2}))
+	for i := 4; i < 10; i++ {
+		setPile(g, Location{Tableau, i}, "Kh", 0)
+	}
+	deal := Move{From: Location{Stock, 0}, To: Location{Tableau, 0}, Count: 10}
+	assert.Equal([]Move{deal}, g.rules.stockMoves(g))
+	assert.NoError(g.Move(deal))
+	assert.Equal(mustParse("As Ts"), g.Pile(Location{Tableau, 0}).Cards())
+	assert.Equal(mustParse("Ts Kh"), g.Pile(Location{Tableau, 9}).Cards())
+	assert.Equal(0, g.Pile(Location{Stock, 0}).CardCount())
+	assert.NoError(g.Undo())
+	assert.Equal(10, g.Pile(Location{Stock, 0}).CardCount())
+	assert.Equal(mustParse("Ts"), g.Pile(Location{Tableau, 0}).Cards())
+}
+
+func TestSpiderCompleteRun(t *testing.T) {
+	assert := assert.New(t)
+	g := emptySpider(1)
+	setPile(g, Location{Tableau, 0}, "As", 0)
+	setPile(g, Location{Tableau, 1}, "2s 3s 4s 5s 6s 7s 8s 9s Ts Js Qs Ks Kh", 1)
+	assert.NoError(g.Move(Move{From: Location{Tableau, 0}, To: Location{Tableau, 1}, Count: 1}))
+	assert.Equal(13, g.Pile(Location{Foundation, 0}).CardCount())
+	assert.Equal(mustParse("Kh"), g.Pile(Location{Tableau, 1}).FaceUp())
+	assert.False(g.Won())
+	assert.Empty(g.AutoPlay())
+	assert.NoError(g.Undo())
+	assert.Equal(0, g.Pile(Location{Foundation, 0}).CardCount())
+	assert.Equal(1, g.Pile(Location{Tableau, 1}).FaceDown())
+
+	setPile(g, Location{Tableau, 1}, "Qs Ks", 0)
+	setPile(g, Location{Tableau, 2}, "2s 3s 4s 5s 6s 7s 8s 9s Ts Js", 0)
+	solution, err := Solve(g, 0)
+	assert.NoError(err)
+	for _, m := range solution {
+		assert.NoError(g.Move(m))
+	}
+	assert.True(g.Won())
+}
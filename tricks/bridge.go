@@ -0,0 +1,46 @@
+package tricks
+
+import (
+	"github.com/anthonyrouseau/games/cards"
+)
+
+//bridge is the rules of the play of a hand of contract Bridge.
+type bridge struct{}
+
+//NewBridge returns the play of a hand of contract Bridge for four players in two partnerships
+//once the auction has made the declarer's contract in the trump suit, or NoTrump.
+//
+//The player after the declarer makes the opening lead.
+//Errors if the hands are not 4 hands of the same size without repeated cards or the declarer is not a player.
+func NewBridge(hands []*cards.Hand, declarer int, trump cards.SuitName) (*Game, error) {
+	g, err := newGame(bridge{}, hands, 4, 4, 2, trump)
+	if err != nil {
+		return nil, err
+	}
+	if declarer < 0 || declarer >= len(hands) {
+		return nil, &InvalidOption{option: "declarer"}
+	}
+	g.turn = g.next(declarer)
+	return g, nil
+}
+
+func (b bridge) name() string {
+	return "Bridge"
+}
+
+func (b bridge) restrict(g *Game, player int, plays []cards.Card) []cards.Card {
+	return nil
+}
+
+//scores returns the tricks each partnership took, scoring them depends on the contract.
+func (b bridge) scores(g *Game) ([]int, []int) {
+	points := make([]int, g.teams)
+	for player, count := range g.taken {
+		points[g.Team(player)] += count
+	}
+	return points, make([]int, g.teams)
+}
+
+func (b bridge) bagLimit() (int, int) {
+	return 0, 0
+}
@@ -0,0 +1,69 @@
+package tricks
+
+import (
+	"fmt"
+
+	"github.com/anthonyrouseau/games/cards"
+)
+
+//InvalidHands signals hands that can not be played in the game.
+//
+//e.g. three hands for Spades or hands of different sizes.
+type InvalidHands struct {
+	game string
+}
+
+func (e *InvalidHands) Error() string {
+	return fmt.Sprintf("The hands can not be played in %s.", e.game)
+}
+
+//InvalidOption signals an option the game does not support.
+//
+//e.g. a dealer who is not one of the players.
+type InvalidOption struct {
+	option string
+}
+
+func (e *InvalidOption) Error() string {
+	return fmt.Sprintf("Option %s is not valid.", e.option)
+}
+
+//InvalidAction signals an action that is not allowed at this point of the hand.
+//
+//e.g. bidding once play has started.
+type InvalidAction struct {
+	action string
+}
+
+func (e *InvalidAction) Error() string {
+	return fmt.Sprintf("Action %s is not allowed now.", e.action)
+}
+
+//NotYourTurn signals a player acting out of turn.
+type NotYourTurn struct {
+	player int
+}
+
+func (e *NotYourTurn) Error() string {
+	return fmt.Sprintf("It is not the turn of player %d.", e.player)
+}
+
+//IllegalPlay signals a card the player may not play.
+//
+//e.g. a card not in their hand or failing to follow suit.
+type IllegalPlay struct {
+	card cards.Card
+}
+
+func (e *IllegalPlay) Error() string {
+	return fmt.Sprintf("Playing %v is not allowed.", e.card)
+}
+
+//InvalidBid signals a bid outside the range the game allows.
+type InvalidBid struct {
+	bid int
+}
+
+func (e *InvalidBid) Error() string {
+	return fmt.Sprintf("Bid %d is not allowed.", e.bid)
+}
@@ -0,0 +1,80 @@
+package tricks
+
+import (
+	"github.com/anthonyrouseau/games/cards"
+)
+
+//euchre is the rules of Euchre once trumps have been made.
+type euchre struct {
+	maker int
+	alone bool
+}
+
+//NewEuchre returns a hand of Euchre for four players in two partnerships once the maker has named trumps.
+//
+//The Jack of trumps, the right bower, is the highest trump followed by the Jack of the other suit
+//of the same color, the left bower, which belongs to the trump suit for the hand.
+//When the maker goes alone their partner sits the hand out.
+//The player after the dealer leads the first trick.
+//Errors if the hands are not 4 hands of the same size without repeated cards,
+//the dealer or maker are not players or trumps are not a suit.
+func NewEuchre(hands []*cards.Hand, dealer, maker int, trump cards.SuitName, alone bool) (*Game, error) {
+	r := euchre{maker: maker, alone: alone}
+	if trump == NoTrump {
+		return nil, &InvalidOption{option: "trump"}
+	}
+	g, err := newGame(r, hands, 4, 4, 2, trump)
+	if err != nil {
+		return nil, err
+	}
+	if dealer < 0 || dealer >= len(hands) {
+		return nil, &InvalidOption{option: "dealer"}
+	}
+	if maker < 0 || maker >= len(hands) {
+		return nil, &InvalidOption{option: "maker"}
+	}
+	g.bowers = true
+	if alone {
+		g.active[(maker+2)%len(hands)] = false
+	}
+	g.turn = g.next(dealer)
+	return g, nil
+}
+
+func (e euchre) name() string {
+	return "Euchre"
+}
+
+func (e euchre) restrict(g *Game, player int, plays []cards.Card) []cards.Card {
+	return nil
+}
+
+//scores returns the points of each partnership.
+//
+//The makers score 1 point for taking 3 or 4 tricks and 2 for all 5, 4 when going alone.
+//Otherwise they are euchred and the defenders score 2 points.
+func (e euchre) scores(g *Game) ([]int, []int) {
+	points := make([]int, g.teams)
+	makers := g.Team(e.maker)
+	taken := 0
+	for player, count := range g.taken {
+		if g.Team(player) == makers {
+			taken += count
+		}
+	}
+	switch {
+	case taken == len(g.tricks) && e.alone:
+		points[makers] = 4
+	case taken == len(g.tricks):
+		points[makers] = 2
+	case 2*taken > len(g.tricks):
+		points[makers] = 1
+	default:
+		points[1-makers] = 2
+	}
+	return points, make([]int, g.teams)
+}
+
+func (e euchre) bagLimit() (int, int) {
+	return 0, 0
+}
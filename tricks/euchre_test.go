@@ -0,0 +1,61 @@
+package tricks
+
+import (
+	"testing"
+
+	"github.com/anthonyrouseau/games/cards"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEuchreBowers(t *testing.T) {
+	assert := assert.New(t)
+	g, err := NewEuchre(hands("Jd 9c", "Ah Tc", "Kc Qc", "Jh Ac"), 3, 0, cards.Hearts, true)
+	assert.NoError(err)
+	assert.Equal("Euchre", g.Name())
+	assert.Equal(0, g.Turn())
+	play(assert, g, 0, "Jd")
+	//The left bower leads trumps.
+	assert.Equal(mustParse("Ah"), g.Legal(1))
+	play(assert, g, 1, "Ah")
+	//Player 2 sits out while their partner goes alone.
+	assert.Equal(3, g.Turn())
+	play(assert, g, 3, "Jh")
+	assert.Equal(3, g.Tricks()[0].Winner())
+	play(assert, g, 3, "Ac")
+	play(assert, g, 0, "9c")
+	play(assert, g, 1, "Tc")
+	assert.Equal(Finished, g.Phase())
+	assert.Equal([]int{0, 2}, g.Scores())
+
+	g, _ = NewEuchre(hands("Jh Jd", "Ah Tc", "Kc Qc", "9c Ac"), 3, 0, cards.Hearts, true)
+	play(assert, g, 0, "Jd")
+	play(assert, g, 1, "Ah")
+	play(assert, g, 3, "9c")
+	play(assert, g, 0, "Jh")
+	play(assert, g, 1, "Tc")
+	play(assert, g, 3, "Ac")
+	assert.Equal([]int{4, 0}, g.Scores())
+
+	_, err = NewEuchre(hands("Jh", "Ah", "Kc", "9c"), 3, 0, NoTrump, false)
+	assert.IsType(&InvalidOption{}, err)
+}
+
+func TestEuchreFollowSuit(t *testing.T) {
+	assert := assert.New(t)
+	g, _ := NewEuchre(hands("9d Qs", "Jh 9s", "Td Ks", "Ad Kd"), 3, 1, cards.Diamonds, false)
+	play(assert, g, 0, "Qs")
+	assert.Equal(mustParse("9s"), g.Legal(1))
+	play(assert, g, 1, "9s")
+	play(assert, g, 2, "Ks")
+	play(assert, g, 3, "Kd")
+	play(assert, g, 3, "Ad")
+	//The Jack of Hearts is a Diamond so must follow.
+	assert.Equal(mustParse("9d"), g.Legal(0))
+	play(assert, g, 0, "9d")
+	assert.Equal(mustParse("Jh"), g.Legal(1))
+	play(assert, g, 1, "Jh")
+	play(assert, g, 2, "Td")
+	assert.Equal(1, g.Tricks()[1].Winner())
+	//The makers take every trick.
+	assert.Equal([]int{0, 2}, g.Scores())
+}
@@ -0,0 +1,354 @@
+package tricks
+
+import (
+	"github.com/anthonyrouseau/games/cards"
+)
+
+//NoTrump is the trump of a hand played without a trump suit.
+const NoTrump cards.SuitName = ""
+
+//Phase is the point a hand has reached.
+type Phase int
+
+//Phase values
+const (
+	//Bidding waits for each player's bid in turn.
+	Bidding Phase = iota
+	//Playing waits for the player whose turn it is to play a card.
+	Playing
+	//Finished has played every trick and can be scored.
+	Finished
+)
+
+//rules are the parts of a trick-taking game that differ between games.
+type rules interface {
+	//name returns the name of the game.
+	name() string
+	//restrict returns the plays the game allows a player other than by following suit.
+	//Returning no cards allows every play.
+	restrict(g *Game, player int, plays []cards.Card) []cards.Card
+	//scores returns the points each team scored and the bags, overtricks, they took.
+	scores(g *Game) (points, bags []int)
+	//bagLimit returns the number of bags a team collects before losing penalty points, 0 for no limit.
+	bagLimit() (limit, penalty int)
+}
+
+//Game is a hand of a trick-taking game.
+//
+//Every game shares leading and following, following suit, trumps and winning tricks,
+//only the extra restrictions on plays, bidding and scoring differ.
+//Players are numbered from 0 in the order they play and each plays from their own Hand.
+type Game struct {
+	rules     rules
+	hands     []*cards.Hand
+	active    []bool
+	teams     int
+	trump     cards.SuitName
+	bowers    bool
+	breakSuit cards.SuitName
+	broken    bool
+	phase     Phase
+	turn      int
+	bids      []int
+	tricks    []Trick
+	current   Trick
+	taken     []int
+}
+
+//newGame returns a game playing the hands in the Playing phase with the first player to lead.
+//
+//Errors if there are fewer than min or more than max hands, the hands are different sizes or empty,
+//or a card is repeated.
+func newGame(r rules, hands []*cards.Hand, min, max, teams int, trump cards.SuitName) (*Game, error) {
+	if len(hands) < min || len(hands) > max {
+		return nil, &InvalidHands{game: r.name()}
+	}
+	seen := map[cards.Card]bool{}
+	for _, hand := range hands {
+		if hand.CardCount() != hands[0].CardCount() || hand.CardCount() == 0 {
+			return nil, &InvalidHands{game: r.name()}
+		}
+		for _, card := range hand.Cards() {
+			if seen[card] {
+				return nil, &InvalidHands{game: r.name()}
+			}
+			seen[card] = true
+		}
+	}
+	switch trump {
+	case NoTrump, cards.Clubs, cards.Diamonds, cards.Hearts, cards.Spades:
+	default:
+		return nil, &InvalidOption{option: "trump"}
+	}
+	g := &Game{
+		rules:   r,
+		hands:   hands,
+		active:  make([]bool, len(hands)),
+		teams:   teams,
+		trump:   trump,
+		phase:   Playing,
+		current: newTrick(),
+		taken:   make([]int, len(hands)),
+	}
+	for i := range g.active {
+		g.active[i] = true
+	}
+	return g, nil
+}
+
+//Name returns the name of the game e.g. "Hearts".
+func (g *Game) Name() string {
+	return g.rules.name()
+}
+
+//Phase returns the point the hand has reached.
+func (g *Game) Phase() Phase {
+	return g.phase
+}
+
+//Players returns the number of players.
+func (g *Game) Players() int {
+	return len(g.hands)
+}
+
+//Hand returns the cards a player has left to play.
+func (g *Game) Hand(player int) *cards.Hand {
+	return g.hands[player]
+}
+
+//Team returns the team of a player, players are partnered with those sitting Teams apart.
+func (g *Game) Team(player int) int {
+	return player % g.teams
+}
+
+//Teams returns the number of teams, the number of players when each plays for themselves.
+func (g *Game) Teams() int {
+	return g.teams
+}
+
+//Turn returns the player who bids or plays next, -1 once the hand is finished.
+func (g *Game) Turn() int {
+	if g.phase == Finished {
+		return -1
+	}
+	return g.turn
+}
+
+//Trump returns the trump suit, NoTrump if there is none.
+func (g *Game) Trump() cards.SuitName {
+	return g.trump
+}
+
+//Broken returns true once a card of the suit that may not be led until broken has been played
+//e.g. Hearts in Hearts or Spades in Spades.
+func (g *Game) Broken() bool {
+	return g.broken
+}
+
+//Trick returns the trick being played.
+func (g *Game) Trick() Trick {
+	return g.current
+}
+
+//Tricks returns the history of completed tricks in the order they were played.
+func (g *Game) Tricks() []Trick {
+	return append([]Trick{}, g.tricks...)
+}
+
+//TricksTaken returns the number of tricks a player has won.
+func (g *Game) TricksTaken(player int) int {
+	return g.taken[player]
+}
+
+//Bids returns the bids made in player order, -1 for players yet to bid.
+func (g *Game) Bids() []int {
+	return append([]int{}, g.bids...)
+}
+
+//Scores returns the points each team scored in the hand, nil until the hand is finished.
+func (g *Game) Scores() []int {
+	if g.phase != Finished {
+		return nil
+	}
+	points, _ := g.rules.scores(g)
+	return points
+}
+
+//Bags returns the overtricks each team took beyond their bids, nil until the hand is finished.
+func (g *Game) Bags() []int {
+	if g.phase != Finished {
+		return nil
+	}
+	_, bags := g.rules.scores(g)
+	return bags
+}
+
+//Legal returns the cards the player may play, none when it is not their turn to play.
+//
+//A player must follow the suit led when they can, with the left bower counting as a trump in Euchre,
+//and the rules of the game may restrict plays further.
+func (g *Game) Legal(player int) []cards.Card {
+	if g.phase != Playing || player != g.turn {
+		return nil
+	}
+	plays := g.hands[player].Cards()
+	if led, ok := g.current.led(); ok {
+		following := []cards.Card{}
+		for _, card := range plays {
+			if g.sameSuit(card, led) {
+				following = append(following, card)
+			}
+		}
+		if len(following) > 0 {
+			plays = following
+		}
+	}
+	if restricted := g.rules.restrict(g, player, plays); len(restricted) > 0 {
+		plays = restricted
+	}
+	return plays
+}
+
+//Play plays a card from the player's hand to the current trick.
+//
+//Once every player has played the trick is won by the highest trump or,
+//without trumps, the highest card of the suit led, Aces high, and its winner leads the next.
+//Errors if the hand is not being played, it is not the player's turn or the card is not legal.
+func (g *Game) Play(player int, card cards.Card) error {
+	if g.phase != Playing {
+		return &InvalidAction{action: "Play"}
+	}
+	if player != g.turn {
+		return &NotYourTurn{player: player}
+	}
+	legal := false
+	for _, play := range g.Legal(player) {
+		legal = legal || play.Matches(&card)
+	}
+	if !legal {
+		return &IllegalPlay{card: card}
+	}
+	hand := g.hands[player]
+	for i, held := range hand.Cards() {
+		if held.Matches(&card) {
+			hand.Pick([]int{i})
+			break
+		}
+	}
+	g.current.plays = append(g.current.plays, Play{Player: player, Card: card})
+	if g.breakSuit != "" && card.Suit().Name() == g.breakSuit {
+		g.broken = true
+	}
+	if len(g.current.plays) < g.activeCount() {
+		g.turn = g.next(player)
+		return nil
+	}
+	winner := g.current.plays[0]
+	for _, play := range g.current.plays[1:] {
+		if g.power(play.Card) > g.power(winner.Card) {
+			winner = play
+		}
+	}
+	g.current.winner = winner.Player
+	g.taken[winner.Player]++
+	g.tricks = append(g.tricks, g.current)
+	g.current = newTrick()
+	g.turn = winner.Player
+	if g.hands[winner.Player].CardCount() == 0 {
+		g.phase = Finished
+	}
+	return nil
+}
+
+//next returns the next player taking part after the player.
+func (g *Game) next(player int) int {
+	for {
+		player = (player + 1) % len(g.hands)
+		if g.active[player] {
+			return player
+		}
+	}
+}
+
+//activeCount returns the number of players taking part.
+func (g *Game) activeCount() int {
+	count := 0
+	for _, active := range g.active {
+		if active {
+			count++
+		}
+	}
+	return count
+}
+
+//leading returns true if the next card played leads a trick.
+func (g *Game) leading() bool {
+	return len(g.current.plays) == 0
+}
+
+//firstTrick returns true while the first trick of the hand is being played.
+func (g *Game) firstTrick() bool {
+	return len(g.tricks) == 0
+}
+
+//isTrump returns true if the card is a trump.
+func (g *Game) isTrump(card cards.Card) bool {
+	return g.trump != NoTrump && (card.Suit().Name() == g.trump || g.isLeftBower(card))
+}
+
+//isLeftBower returns true if the card is the Jack of the suit of the same color as trumps when bowers are played.
+func (g *Game) isLeftBower(card cards.Card) bool {
+	if !g.bowers || card.Rank() != cards.Jack || card.Suit().Name() == g.trump {
+		return false
+	}
+	trump, _ := cards.NewCard(cards.Jack, g.trump)
+	return card.MatchesColor(&trump)
+}
+
+//sameSuit returns true if the card follows the suit of the led card.
+func (g *Game) sameSuit(card, led cards.Card) bool {
+	if g.isLeftBower(card) || g.isLeftBower(led) {
+		return g.isTrump(card) && g.isTrump(led)
+	}
+	return card.MatchesSuit(&led)
+}
+
+//power returns how strongly a card plays to the current trick, 0 when it can not win.
+func (g *Game) power(card cards.Card) int {
+	led, _ := g.current.led()
+	switch {
+	case g.isLeftBower(card):
+		return 199
+	case g.isTrump(card) && g.bowers && card.Rank() == cards.Jack:
+		return 200
+	case g.isTrump(card):
+		return 100 + rankValue(card.Rank())
+	case g.sameSuit(card, led):
+		return rankValue(card.Rank())
+	}
+	return 0
+}
+
+//rankValue returns the value of a rank with Aces high.
+func rankValue(rank cards.Rank) int {
+	if rank == cards.Ace {
+		return int(cards.King) + 1
+	}
+	return int(rank)
+}
+
+//countCards returns the number of cards played in the tricks the player won that match.
+func (g *Game) countCards(player int, match func(cards.Card) bool) int {
+	count := 0
+	for _, trick := range g.tricks {
+		if trick.winner != player {
+			continue
+		}
+		for _, play := range trick.plays {
+			if match(play.Card) {
+				count++
+			}
+		}
+	}
+	return count
+}
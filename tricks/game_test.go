@@ -0,0 +1,105 @@
+package tricks
+
+import (
+	"testing"
+
+	"github.com/anthonyrouseau/games/cards"
+	"github.com/stretchr/testify/assert"
+)
+
+func mustParse(notation string) []cards.Card {
+	cs, err := cards.ParseCards(notation)
+	if err != nil {
+		panic(err)
+	}
+	return cs
+}
+
+//hands returns a hand for each notation.
+func hands(notations ...string) []*cards.Hand {
+	hs := make([]*cards.Hand, len(notations))
+	for i, notation := range notations {
+		cs := mustParse(notation)
+		pile, _ := cards.NewPile(len(cs), cs...)
+		hs[i] = &cards.Hand{Pile: pile}
+	}
+	return hs
+}
+
+//play plays the card written in notation for the player.
+func play(assert *assert.Assertions, g *Game, player int, notation string) {
+	assert.NoError(g.Play(player, mustParse(notation)[0]))
+}
+
+func TestNewGame(t *testing.T) {
+	assert := assert.New(t)
+	deck := cards.NewStandardDeck(false)
+	deck.Shuffle()
+	dealt, _ := deck.Deal(4, 13)
+	g, err := NewBridge(dealt, 0, NoTrump)
+	assert.NoError(err)
+	assert.Equal("Bridge", g.Name())
+	assert.Equal(Playing, g.Phase())
+	assert.Equal(1, g.Turn())
+	assert.Equal(2, g.Teams())
+	assert.Equal(g.Team(0), g.Team(2))
+
+	tests := []struct {
+		hands []*cards.Hand
+		trump cards.SuitName
+	}{
+		{hands("As", "Ks", "Qs"), NoTrump},
+		{hands("As", "Ks", "Qs", "Js Ts"), NoTrump},
+		{hands("As", "Ks", "Qs", "As"), NoTrump},
+		{hands("As", "Ks", "Qs", "Js"), cards.Joker},
+	}
+	for _, test := range tests {
+		_, err := NewBridge(test.hands, 0, test.trump)
+		assert.Error(err)
+	}
+	_, err = NewBridge(hands("As", "Ks", "Qs", "Js"), 4, NoTrump)
+	assert.IsType(&InvalidOption{}, err)
+}
+
+func TestPlay(t *testing.T) {
+	assert := assert.New(t)
+	g, _ := NewBridge(hands("As Kh 2c", "3s 4h 5d", "7s 8c 9h", "Qd Jh Tc"), 3, cards.Hearts)
+	assert.Equal(0, g.Turn())
+	assert.Nil(g.Legal(1))
+	assert.IsType(&NotYourTurn{}, g.Play(1, mustParse("3s")[0]))
+	assert.IsType(&IllegalPlay{}, g.Play(0, mustParse("Ah")[0]))
+	play(assert, g, 0, "As")
+	assert.Equal(mustParse("3s"), g.Legal(1))
+	assert.IsType(&IllegalPlay{}, g.Play(1, mustParse("4h")[0]))
+	play(assert, g, 1, "3s")
+	play(assert, g, 2, "7s")
+	assert.Equal(0, g.Trick().Leader())
+	assert.Equal(-1, g.Trick().Winner())
+	//Void in Spades so any card may be played.
+	assert.Len(g.Legal(3), 3)
+	play(assert, g, 3, "Jh")
+
+	history := g.Tricks()
+	assert.Len(history, 1)
+	assert.Equal(3, history[0].Winner())
+	assert.Equal(mustParse("As 3s 7s Jh"), history[0].Cards())
+	assert.Equal(Play{Player: 1, Card: mustParse("3s")[0]}, history[0].Plays()[1])
+	assert.Equal(3, g.Turn())
+	assert.Equal(2, g.Hand(3).CardCount())
+
+	play(assert, g, 3, "Qd")
+	play(assert, g, 0, "Kh")
+	play(assert, g, 1, "5d")
+	play(assert, g, 2, "9h")
+	assert.Equal(0, g.Tricks()[1].Winner())
+	play(assert, g, 0, "2c")
+	play(assert, g, 1, "4h")
+	play(assert, g, 2, "8c")
+	play(assert, g, 3, "Tc")
+
+	assert.Equal(Finished, g.Phase())
+	assert.Equal(-1, g.Turn())
+	assert.Equal(1, g.TricksTaken(1))
+	assert.Equal([]int{1, 2}, g.Scores())
+	assert.IsType(&InvalidAction{}, g.Play(1, mustParse("4h")[0]))
+}
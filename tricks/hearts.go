@@ -0,0 +1,102 @@
+package tricks
+
+import (
+	"github.com/anthonyrouseau/games/cards"
+)
+
+//HeartsRules are the options of a game of Hearts.
+type HeartsRules struct {
+	//BreakHearts stops Hearts being led until a Heart has been played, unless the leader holds nothing else.
+	BreakHearts bool
+	//QueenOfSpades is the penalty for taking the Queen of Spades, 0 for none.
+	QueenOfSpades int
+	//FirstTrickPoints allows Hearts and the Queen of Spades to be played to the first trick.
+	FirstTrickPoints bool
+	//ShootTheMoon gives every other player the points instead when one player takes them all.
+	ShootTheMoon bool
+}
+
+//DefaultHeartsRules returns the usual rules of Hearts.
+//
+//Hearts must be broken, the Queen of Spades costs 13 points, no points may be played to the first trick
+//and a player taking every point shoots the moon.
+func DefaultHeartsRules() HeartsRules {
+	return HeartsRules{BreakHearts: true, QueenOfSpades: 13, ShootTheMoon: true}
+}
+
+func (r HeartsRules) name() string {
+	return "Hearts"
+}
+
+//NewHearts returns a hand of Hearts played by 3 to 6 players, each playing for themselves.
+//
+//The holder of the Two of Clubs leads it to the first trick, or player 0 leads when it was not dealt.
+//Errors if the hands are not 3 to 6 hands of the same size without repeated cards.
+func NewHearts(rules HeartsRules, hands []*cards.Hand) (*Game, error) {
+	g, err := newGame(rules, hands, 3, 6, len(hands), NoTrump)
+	if err != nil {
+		return nil, err
+	}
+	g.breakSuit = cards.Hearts
+	twoOfClubs, _ := cards.NewCard(cards.Two, cards.Clubs)
+	for i, hand := range hands {
+		if hand.HasCard(&twoOfClubs) {
+			g.turn = i
+		}
+	}
+	return g, nil
+}
+
+//restrict makes the Two of Clubs lead the first trick, stops unbroken Hearts being led
+//and points being played to the first trick.
+func (r HeartsRules) restrict(g *Game, player int, plays []cards.Card) []cards.Card {
+	allowed := []cards.Card{}
+	for _, card := range plays {
+		switch {
+		case g.leading() && g.firstTrick() && card.Rank() == cards.Two && card.Suit().Name() == cards.Clubs:
+			return []cards.Card{card}
+		case g.leading() && r.BreakHearts && !g.broken && card.Suit().Name() == cards.Hearts:
+		case g.firstTrick() && !r.FirstTrickPoints && r.points(card) > 0:
+		default:
+			allowed = append(allowed, card)
+		}
+	}
+	return allowed
+}
+
+//points returns the penalty for taking a card.
+func (r HeartsRules) points(card cards.Card) int {
+	switch {
+	case card.Suit().Name() == cards.Hearts:
+		return 1
+	case card.Suit().Name() == cards.Spades && card.Rank() == cards.Queen:
+		return r.QueenOfSpades
+	}
+	return 0
+}
+
+//scores returns the penalty points each player took.
+func (r HeartsRules) scores(g *Game) ([]int, []int) {
+	points := make([]int, len(g.hands))
+	total := 0
+	for _, trick := range g.tricks {
+		for _, play := range trick.plays {
+			points[trick.winner] += r.points(play.Card)
+			total += r.points(play.Card)
+		}
+	}
+	for shooter, taken := range points {
+		if r.ShootTheMoon && total > 0 && taken == total {
+			for i := range points {
+				points[i] = total
+			}
+			points[shooter] = 0
+			break
+		}
+	}
+	return points, make([]int, len(g.hands))
+}
+
+func (r HeartsRules) bagLimit() (int, int) {
+	return 0, 0
+}
@@ -0,0 +1,78 @@
+package tricks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHearts(t *testing.T) {
+	assert := assert.New(t)
+	g, err := NewHearts(DefaultHeartsRules(), hands("3c Qs 5h", "2c Ah 4d", "Kc 2h 9d", "Tc 3h Jd"))
+	assert.NoError(err)
+	assert.Equal(1, g.Turn())
+	assert.Equal(4, g.Teams())
+	assert.Equal(mustParse("2c"), g.Legal(1))
+	play(assert, g, 1, "2c")
+	play(assert, g, 2, "Kc")
+	play(assert, g, 3, "Tc")
+	play(assert, g, 0, "3c")
+	//Hearts are not broken.
+	assert.Equal(mustParse("9d"), g.Legal(2))
+	play(assert, g, 2, "9d")
+	play(assert, g, 3, "Jd")
+	assert.Equal(mustParse("Qs 5h"), g.Legal(0))
+	play(assert, g, 0, "Qs")
+	play(assert, g, 1, "4d")
+	assert.False(g.Broken())
+	//Only Hearts are left to lead.
+	assert.Equal(mustParse("3h"), g.Legal(3))
+	play(assert, g, 3, "3h")
+	play(assert, g, 0, "5h")
+	play(assert, g, 1, "Ah")
+	play(assert, g, 2, "2h")
+	assert.True(g.Broken())
+	assert.Equal([]int{0, 4, 0, 13}, g.Scores())
+	assert.Equal([]int{0, 0, 0, 0}, g.Bags())
+}
+
+func TestHeartsFirstTrick(t *testing.T) {
+	assert := assert.New(t)
+	g, _ := NewHearts(DefaultHeartsRules(), hands("2c 3c", "5h 9d", "Qs 8c", "Kh 4c"))
+	play(assert, g, 0, "2c")
+	assert.Equal(mustParse("9d"), g.Legal(1))
+	play(assert, g, 1, "9d")
+	assert.Equal(mustParse("8c"), g.Legal(2))
+
+	rules := DefaultHeartsRules()
+	rules.FirstTrickPoints = true
+	g, _ = NewHearts(rules, hands("2c 3c", "5h 9d", "Qs 8c", "Kh 4c"))
+	play(assert, g, 0, "2c")
+	assert.Equal(mustParse("5h 9d"), g.Legal(1))
+}
+
+func TestShootTheMoon(t *testing.T) {
+	assert := assert.New(t)
+	deal := []string{"2c 3h", "Ac Ah", "4c Qs", "5c 2h"}
+	g, _ := NewHearts(DefaultHeartsRules(), hands(deal...))
+	playMoon(assert, g)
+	assert.Equal([]int{16, 0, 16, 16}, g.Scores())
+
+	rules := DefaultHeartsRules()
+	rules.ShootTheMoon = false
+	rules.QueenOfSpades = 0
+	g, _ = NewHearts(rules, hands(deal...))
+	playMoon(assert, g)
+	assert.Equal([]int{0, 3, 0, 0}, g.Scores())
+}
+
+func playMoon(assert *assert.Assertions, g *Game) {
+	play(assert, g, 0, "2c")
+	play(assert, g, 1, "Ac")
+	play(assert, g, 2, "4c")
+	play(assert, g, 3, "5c")
+	play(assert, g, 1, "Ah")
+	play(assert, g, 2, "Qs")
+	play(assert, g, 3, "2h")
+	play(assert, g, 0, "3h")
+}
@@ -0,0 +1,54 @@
+package tricks
+
+//Scoreboard keeps the running scores of each team over the hands of a game.
+type Scoreboard struct {
+	points []int
+	bags   []int
+	hands  int
+}
+
+//NewScoreboard returns a scoreboard for the number of teams with every score at 0.
+func NewScoreboard(teams int) *Scoreboard {
+	return &Scoreboard{points: make([]int, teams), bags: make([]int, teams)}
+}
+
+//Record adds the scores of a finished hand.
+//
+//Bags are carried between hands and a team reaching the game's bag limit loses its penalty
+//and the limit is taken from its bags.
+//Errors if the hand is not finished or was played by a different number of teams.
+func (s *Scoreboard) Record(g *Game) error {
+	if g.phase != Finished {
+		return &InvalidAction{action: "Record"}
+	}
+	if g.teams != len(s.points) {
+		return &InvalidOption{option: "teams"}
+	}
+	points, bags := g.rules.scores(g)
+	limit, penalty := g.rules.bagLimit()
+	for team := range s.points {
+		s.points[team] += points[team]
+		s.bags[team] += bags[team]
+		for limit > 0 && s.bags[team] >= limit {
+			s.bags[team] -= limit
+			s.points[team] -= penalty
+		}
+	}
+	s.hands++
+	return nil
+}
+
+//Points returns the total points of a team.
+func (s *Scoreboard) Points(team int) int {
+	return s.points[team]
+}
+
+//Bags returns the bags a team carries towards the bag limit.
+func (s *Scoreboard) Bags(team int) int {
+	return s.bags[team]
+}
+
+//Hands returns the number of hands recorded.
+func (s *Scoreboard) Hands() int {
+	return s.hands
+}
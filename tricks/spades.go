@@ -0,0 +1,118 @@
+package tricks
+
+import (
+	"github.com/anthonyrouseau/games/cards"
+)
+
+//SpadesRules are the options of a game of Spades.
+type SpadesRules struct {
+	//NilBonus is won by a player bidding nil who takes no tricks and lost when they take any.
+	NilBonus int
+	//BagLimit is the number of bags a team collects before losing BagPenalty points, 0 for no limit.
+	BagLimit   int
+	BagPenalty int
+	//BreakSpades stops Spades being led until a Spade has been played, unless the leader holds nothing else.
+	BreakSpades bool
+}
+
+//DefaultSpadesRules returns the usual rules of Spades.
+//
+//Nil is worth 100 points, every 10 bags cost 100 points and Spades must be broken.
+func DefaultSpadesRules() SpadesRules {
+	return SpadesRules{NilBonus: 100, BagLimit: 10, BagPenalty: 100, BreakSpades: true}
+}
+
+func (r SpadesRules) name() string {
+	return "Spades"
+}
+
+//NewSpades returns a hand of Spades for four players in two partnerships with Spades as trumps.
+//
+//The hand starts in the Bidding phase with the player after the dealer, who also leads the first trick.
+//Errors if the hands are not 4 hands of the same size without repeated cards or the dealer is not a player.
+func NewSpades(rules SpadesRules, dealer int, hands []*cards.Hand) (*Game, error) {
+	g, err := newGame(rules, hands, 4, 4, 2, cards.Spades)
+	if err != nil {
+		return nil, err
+	}
+	if dealer < 0 || dealer >= len(hands) {
+		return nil, &InvalidOption{option: "dealer"}
+	}
+	g.breakSuit = cards.Spades
+	g.phase = Bidding
+	g.turn = g.next(dealer)
+	g.bids = []int{-1, -1, -1, -1}
+	return g, nil
+}
+
+//Bid makes the player's bid of the number of tricks they will take, 0 bids nil.
+//
+//Once every player has bid the player after the dealer leads.
+//Errors if the hand is not being bid, it is not the player's turn or the bid is more than the tricks in the hand.
+func (g *Game) Bid(player, bid int) error {
+	if g.phase != Bidding {
+		return &InvalidAction{action: "Bid"}
+	}
+	if player != g.turn {
+		return &NotYourTurn{player: player}
+	}
+	if bid < 0 || bid > g.hands[player].CardCount() {
+		return &InvalidBid{bid: bid}
+	}
+	g.bids[player] = bid
+	g.turn = g.next(player)
+	if g.bids[g.turn] >= 0 {
+		g.phase = Playing
+	}
+	return nil
+}
+
+//restrict stops unbroken Spades being led.
+func (r SpadesRules) restrict(g *Game, player int, plays []cards.Card) []cards.Card {
+	if !g.leading() || !r.BreakSpades || g.broken {
+		return nil
+	}
+	allowed := []cards.Card{}
+	for _, card := range plays {
+		if card.Suit().Name() != cards.Spades {
+			allowed = append(allowed, card)
+		}
+	}
+	return allowed
+}
+
+//scores returns the points of each partnership.
+//
+//A partnership making the total of its bids scores 10 points a trick bid and a point for each bag, overtrick,
+//otherwise it loses 10 points a trick bid. Each nil bid wins or loses the NilBonus and the tricks
+//of a player bidding nil do not count towards their partner's bid.
+func (r SpadesRules) scores(g *Game) ([]int, []int) {
+	points, bags := make([]int, g.teams), make([]int, g.teams)
+	bid, taken := make([]int, g.teams), make([]int, g.teams)
+	for player, playerBid := range g.bids {
+		team := g.Team(player)
+		if playerBid > 0 {
+			bid[team] += playerBid
+			taken[team] += g.taken[player]
+			continue
+		}
+		if g.taken[player] == 0 {
+			points[team] += r.NilBonus
+		} else {
+			points[team] -= r.NilBonus
+		}
+	}
+	for team := range points {
+		if taken[team] >= bid[team] {
+			bags[team] = taken[team] - bid[team]
+			points[team] += 10*bid[team] + bags[team]
+		} else {
+			points[team] -= 10 * bid[team]
+		}
+	}
+	return points, bags
+}
+
+func (r SpadesRules) bagLimit() (int, int) {
+	return r.BagLimit, r.BagPenalty
+}
@@ -0,0 +1,71 @@
+package tricks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpades(t *testing.T) {
+	assert := assert.New(t)
+	g, err := NewSpades(DefaultSpadesRules(), 3, hands("As Ah", "Kh 3d", "4h 5d", "2s Qh"))
+	assert.NoError(err)
+	assert.Equal(Bidding, g.Phase())
+	assert.Equal(0, g.Turn())
+	assert.IsType(&InvalidAction{}, g.Play(0, mustParse("Ah")[0]))
+	assert.IsType(&NotYourTurn{}, g.Bid(1, 1))
+	assert.IsType(&InvalidBid{}, g.Bid(0, 3))
+	assert.NoError(g.Bid(0, 1))
+	assert.NoError(g.Bid(1, 1))
+	assert.NoError(g.Bid(2, 0))
+	assert.Equal([]int{1, 1, 0, -1}, g.Bids())
+	assert.NoError(g.Bid(3, 1))
+	assert.Equal(Playing, g.Phase())
+	assert.IsType(&InvalidAction{}, g.Bid(0, 1))
+
+	//Spades are not broken.
+	assert.IsType(&IllegalPlay{}, g.Play(0, mustParse("As")[0]))
+	play(assert, g, 0, "Ah")
+	play(assert, g, 1, "Kh")
+	play(assert, g, 2, "4h")
+	play(assert, g, 3, "Qh")
+	assert.Equal(mustParse("As"), g.Legal(0))
+	play(assert, g, 0, "As")
+	play(assert, g, 1, "3d")
+	play(assert, g, 2, "5d")
+	play(assert, g, 3, "2s")
+	assert.True(g.Broken())
+	assert.Equal(2, g.TricksTaken(0))
+	assert.Equal([]int{111, -20}, g.Scores())
+	assert.Equal([]int{1, 0}, g.Bags())
+
+	rules := DefaultSpadesRules()
+	rules.BagLimit, rules.BagPenalty = 2, 50
+	scoreboard := NewScoreboard(2)
+	assert.NoError(scoreboard.Record(g))
+	assert.Equal(1, scoreboard.Bags(0))
+	g.rules = rules
+	assert.NoError(scoreboard.Record(g))
+	assert.Equal(222-50, scoreboard.Points(0))
+	assert.Equal(0, scoreboard.Bags(0))
+	assert.Equal(-40, scoreboard.Points(1))
+	assert.Equal(2, scoreboard.Hands())
+
+	unfinished, _ := NewSpades(rules, 0, hands("As", "Ks", "Qs", "Js"))
+	assert.IsType(&InvalidAction{}, scoreboard.Record(unfinished))
+	assert.IsType(&InvalidOption{}, NewScoreboard(4).Record(g))
+}
+
+func TestSpadesNil(t *testing.T) {
+	assert := assert.New(t)
+	g, _ := NewSpades(DefaultSpadesRules(), 3, hands("Ah", "Kh", "4h", "2s"))
+	for player, bid := range []int{0, 1, 0, 0} {
+		assert.NoError(g.Bid(player, bid))
+	}
+	play(assert, g, 0, "Ah")
+	play(assert, g, 1, "Kh")
+	play(assert, g, 2, "4h")
+	play(assert, g, 3, "2s")
+	//Player 3 fails nil and player 1 fails the bid of 1.
+	assert.Equal([]int{200, -110}, g.Scores())
+}
@@ -0,0 +1,56 @@
+package tricks
+
+import (
+	"github.com/anthonyrouseau/games/cards"
+)
+
+//Play is a card played to a trick by a player.
+type Play struct {
+	Player int
+	Card   cards.Card
+}
+
+//Trick is the cards played in turn to a trick, led by the first.
+type Trick struct {
+	plays  []Play
+	winner int
+}
+
+func newTrick() Trick {
+	return Trick{winner: -1}
+}
+
+//Plays returns the plays made to the trick in order.
+func (t Trick) Plays() []Play {
+	return append([]Play{}, t.plays...)
+}
+
+//Cards returns the cards played to the trick in order.
+func (t Trick) Cards() []cards.Card {
+	cs := make([]cards.Card, len(t.plays))
+	for i, play := range t.plays {
+		cs[i] = play.Card
+	}
+	return cs
+}
+
+//Leader returns the player who led the trick, -1 before a card is played.
+func (t Trick) Leader() int {
+	if len(t.plays) == 0 {
+		return -1
+	}
+	return t.plays[0].Player
+}
+
+//Winner returns the player who won the trick, -1 until every player has played to it.
+func (t Trick) Winner() int {
+	return t.winner
+}
+
+//led returns the card that led the trick.
+func (t Trick) led() (cards.Card, bool) {
+	if len(t.plays) == 0 {
+		return cards.Card{}, false
+	}
+	return t.plays[0].Card, true
+}